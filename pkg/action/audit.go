@@ -0,0 +1,118 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package action
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+// AuditOptions appends an audit record to the file specified by --audit-log
+// when a mutating command succeeds.
+type AuditOptions struct {
+	// AuditLog is the path of the audit log file, no record is written if it is empty
+	AuditLog string `json:"-"`
+
+	cmd *cobra.Command
+}
+
+// auditRecord is a line of the audit log
+type auditRecord struct {
+	Time      string `json:"time"`
+	Command   string `json:"command"`
+	Resource  string `json:"resource"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	User      string `json:"user"`
+}
+
+// AddAuditLogFlag adds the --audit-log flag, the command is recorded in the audit records.
+func (o *AuditOptions) AddAuditLogFlag(cmd *cobra.Command, persistent bool) {
+	o.cmd = cmd
+	fs := cmd.Flags()
+	if persistent {
+		fs = cmd.PersistentFlags()
+	}
+	fs.StringVar(&o.AuditLog, "audit-log", "", "Append a JSON line to the specified file for each resource changed successfully, for compliance auditing")
+}
+
+// WriteAuditLog appends an audit record of the changed resource to the audit log file.
+func (o *AuditOptions) WriteAuditLog(f cmdutil.Factory, gvr schema.GroupVersionResource, namespace, name string) error {
+	if o.AuditLog == "" {
+		return nil
+	}
+	record := auditRecord{
+		Time:      time.Now().UTC().Format(time.RFC3339),
+		Command:   o.command(),
+		Resource:  gvr.Resource,
+		Namespace: namespace,
+		Name:      name,
+		User:      o.user(f),
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(o.AuditLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %v", err)
+	}
+	defer file.Close()
+	if _, err = file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit log: %v", err)
+	}
+	return nil
+}
+
+// command returns the command path without the root command name, such as "cluster create"
+func (o *AuditOptions) command() string {
+	if o.cmd == nil {
+		return ""
+	}
+	return strings.TrimPrefix(o.cmd.CommandPath(), o.cmd.Root().Name()+" ")
+}
+
+// user returns the auth info of the current context in kubeconfig, the --context and --user
+// flags are respected.
+func (o *AuditOptions) user(f cmdutil.Factory) string {
+	rawConfig, err := f.ToRawKubeConfigLoader().RawConfig()
+	if err != nil {
+		return ""
+	}
+	contextName := rawConfig.CurrentContext
+	user := ""
+	if o.cmd != nil {
+		if v, _ := o.cmd.Flags().GetString("context"); v != "" {
+			contextName = v
+		}
+		user, _ = o.cmd.Flags().GetString("user")
+	}
+	if ctx := rawConfig.Contexts[contextName]; user == "" && ctx != nil {
+		user = ctx.AuthInfo
+	}
+	return user
+}
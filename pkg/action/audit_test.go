@@ -0,0 +1,78 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package action
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
+
+	"github.com/apecloud/kbcli/pkg/types"
+)
+
+var _ = Describe("audit", func() {
+	It("write audit log", func() {
+		config := clientcmdapi.NewConfig()
+		config.Contexts["dev"] = &clientcmdapi.Context{AuthInfo: "alice"}
+		config.CurrentContext = "dev"
+		tf := cmdtesting.NewTestFactory().WithClientConfig(clientcmd.NewDefaultClientConfig(*config, &clientcmd.ConfigOverrides{}))
+		defer tf.Cleanup()
+
+		root := &cobra.Command{Use: "kbcli"}
+		clusterCmd := &cobra.Command{Use: "cluster"}
+		backupCmd := &cobra.Command{Use: "backup"}
+		root.AddCommand(clusterCmd)
+		clusterCmd.AddCommand(backupCmd)
+
+		o := &AuditOptions{}
+		o.AddAuditLogFlag(backupCmd, false)
+
+		By("no audit log is written if --audit-log is not specified")
+		Expect(o.WriteAuditLog(tf, types.BackupGVR(), "default", "backup1")).Should(Succeed())
+
+		By("append audit records")
+		o.AuditLog = filepath.Join(GinkgoT().TempDir(), "audit.log")
+		Expect(o.WriteAuditLog(tf, types.BackupGVR(), "default", "backup1")).Should(Succeed())
+		Expect(o.WriteAuditLog(tf, types.BackupGVR(), "default", "backup2")).Should(Succeed())
+		data, err := os.ReadFile(o.AuditLog)
+		Expect(err).ShouldNot(HaveOccurred())
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		Expect(lines).Should(HaveLen(2))
+		record := auditRecord{}
+		Expect(json.Unmarshal([]byte(lines[1]), &record)).Should(Succeed())
+		Expect(record.Time).ShouldNot(BeEmpty())
+		Expect(record).Should(Equal(auditRecord{
+			Time:      record.Time,
+			Command:   "cluster backup",
+			Resource:  "backups",
+			Namespace: "default",
+			Name:      "backup2",
+			User:      "alice",
+		}))
+	})
+})
@@ -101,6 +101,7 @@ type CreateOptions struct {
 	// Quiet minimize unnecessary output
 	Quiet bool
 
+	AuditOptions
 	genericiooptions.IOStreams
 }
 
@@ -200,6 +201,9 @@ func (o *CreateOptions) Run() error {
 
 		if dryRunStrategy != DryRunServer {
 			o.Name = resObj.GetName()
+			if err = o.WriteAuditLog(o.Factory, o.GVR, o.Namespace, o.Name); err != nil {
+				return err
+			}
 			if o.Quiet {
 				return nil
 			}
@@ -59,6 +59,7 @@ type DeleteOptions struct {
 	PreDeleteHook  DeleteHook
 	PostDeleteHook DeleteHook
 
+	AuditOptions
 	genericiooptions.IOStreams
 }
 
@@ -162,8 +163,11 @@ func (o *DeleteOptions) complete() error {
 	return err
 }
 
-func (o *DeleteOptions) AddFlags(cmd *cobra.Command) {
-	cmd.Flags().BoolVarP(&o.AllNamespaces, "all-namespaces", "A", false, "If present, list the requested object(s) across all namespaces. Namespace in current context is ignored even if specified with --namespace.")
+func (o *DeleteOptions) AddFlags(cmd *cobra.Command, isClusterScope ...bool) {
+	if len(isClusterScope) == 0 || !isClusterScope[0] {
+		cmd.Flags().BoolVarP(&o.AllNamespaces, "all-namespaces", "A", false, "If present, list the requested object(s) across all namespaces. Namespace in current context is ignored even if specified with --namespace.")
+	}
+	o.AddAuditLogFlag(cmd, false)
 	cmd.Flags().StringVarP(&o.LabelSelector, "selector", "l", "", "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints.")
 	cmd.Flags().BoolVar(&o.Force, "force", false, "If true, immediately remove resources from API and bypass graceful deletion. Note that immediate deletion of some resources may result in inconsistency or data loss and requires confirmation.")
 	cmd.Flags().BoolVar(&o.Now, "now", false, "If true, resources are signaled for immediate shutdown (same as --grace-period=1).")
@@ -194,6 +198,9 @@ func (o *DeleteOptions) deleteResult(r *resource.Result) error {
 		if err = o.postDeleteResource(info.Object); err != nil {
 			return err
 		}
+		if err = o.WriteAuditLog(o.Factory, o.GVR, info.Namespace, info.Name); err != nil {
+			return err
+		}
 		fmt.Fprintf(o.Out, "%s %s deleted\n", info.Mapping.GroupVersionKind.Kind, info.Name)
 		return nil
 	})
@@ -20,9 +20,11 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package action
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -61,11 +63,21 @@ type ListOptions struct {
 	Names  []string
 	GVR    schema.GroupVersionResource
 	Format printer.Format
+	// Template is the Go template to print each object if Format is template
+	Template string
+
+	// DecorateObject is called on each object before it is printed in JSON, YAML or
+	// template format, it can be used to add derived fields to the output.
+	DecorateObject func(obj *unstructured.Unstructured) error
 
 	// print the result or not, if true, use default printer to print, otherwise,
 	// only return the result to caller.
 	Print  bool
 	SortBy string
+
+	// Ctx is the context of the list requests, usually the context of the command, the requests
+	// are not cancelled if it is nil
+	Ctx context.Context
 	genericiooptions.IOStreams
 }
 
@@ -87,7 +99,7 @@ func (o *ListOptions) AddFlags(cmd *cobra.Command, isClusterScope ...bool) {
 	cmd.Flags().StringVarP(&o.LabelSelector, "selector", "l", o.LabelSelector, "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints.")
 	cmd.Flags().BoolVar(&o.ShowLabels, "show-labels", false, "When printing, show all labels as the last column (default hide labels column)")
 	// Todo: --sortBy supports custom field sorting, now `list` is to sort using the `.metadata.name` field in default
-	printer.AddOutputFlag(cmd, &o.Format)
+	printer.AddListOutputFlag(cmd, &o.Format, &o.Template)
 }
 
 func (o *ListOptions) Complete() error {
@@ -109,6 +121,10 @@ func (o *ListOptions) Complete() error {
 			p = &printers.JSONPrinter{}
 		case printer.YAML:
 			p = &printers.YAMLPrinter{}
+		case printer.Template:
+			if p, err = printer.NewTemplateEncoder(o.Template); err != nil {
+				return nil, err
+			}
 		case printer.Table:
 			p = printers.NewTablePrinter(printers.PrintOptions{
 				Kind:          kind,
@@ -147,7 +163,11 @@ func (o *ListOptions) Run() (*resource.Result, error) {
 		return nil, err
 	}
 
-	r := o.Factory.NewBuilder().
+	builder := o.Factory.NewBuilder()
+	if o.Ctx != nil {
+		builder = resource.NewBuilder(&contextRESTClientGetter{Factory: o.Factory, ctx: o.Ctx})
+	}
+	r := builder.
 		Unstructured().
 		NamespaceParam(o.Namespace).DefaultNamespace().AllNamespaces(o.AllNamespaces).
 		LabelSelectorParam(o.LabelSelector).
@@ -172,6 +192,34 @@ func (o *ListOptions) Run() (*resource.Result, error) {
 	}
 }
 
+// contextRESTClientGetter binds the requests of the resource builder to the context, the builder
+// sends the requests with context.TODO(), so the context is set by the transport.
+type contextRESTClientGetter struct {
+	cmdutil.Factory
+	ctx context.Context
+}
+
+func (g *contextRESTClientGetter) ToRESTConfig() (*rest.Config, error) {
+	config, err := g.Factory.ToRESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	config = rest.CopyConfig(config)
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &contextRoundTripper{ctx: g.ctx, rt: rt}
+	})
+	return config, nil
+}
+
+type contextRoundTripper struct {
+	ctx context.Context
+	rt  http.RoundTripper
+}
+
+func (t *contextRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.rt.RoundTrip(req.WithContext(t.ctx))
+}
+
 func (o *ListOptions) transformRequests(req *rest.Request) {
 	if !o.Format.IsHumanReadable() || !o.Print {
 		return
@@ -256,7 +304,22 @@ func (o *ListOptions) printResult(r *resource.Result) error {
 	if tracingWriter.Written == 0 && len(allErrs) == 0 {
 		o.PrintNotFoundResources()
 	}
-	return utilerrors.NewAggregate(allErrs)
+	return utilerrors.NewAggregate(truncateErrors(allErrs, maxReportedErrors))
+}
+
+// maxReportedErrors is the max number of distinct errors reported by list
+const maxReportedErrors = 10
+
+// truncateErrors keeps the first limit errors and summarizes the rest, to avoid an error wall
+// when the API server is partially unavailable.
+func truncateErrors(errs []error, limit int) []error {
+	if len(errs) <= limit {
+		return errs
+	}
+	if more := len(errs) - limit; more > 1 {
+		return append(errs[:limit:limit], fmt.Errorf("and %d more errors", more))
+	}
+	return append(errs[:limit:limit], fmt.Errorf("and 1 more error"))
 }
 
 type trackingWriterWrapper struct {
@@ -364,7 +427,11 @@ func (o *ListOptions) printGeneric(r *resource.Result) error {
 		}
 
 		for _, item := range items {
-			list.Items = append(list.Items, *item.(*unstructured.Unstructured))
+			u := item.(*unstructured.Unstructured)
+			if err := o.decorate(u); err != nil {
+				return err
+			}
+			list.Items = append(list.Items, *u)
 		}
 		if err := printer.PrintObj(list, o.Out); err != nil {
 			errs = append(errs, err)
@@ -372,6 +439,11 @@ func (o *ListOptions) printGeneric(r *resource.Result) error {
 		return utilerrors.Reduce(utilerrors.Flatten(utilerrors.NewAggregate(errs)))
 	}
 
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		if err := o.decorate(u); err != nil {
+			return err
+		}
+	}
 	if printErr := printer.PrintObj(obj, o.Out); printErr != nil {
 		errs = append(errs, printErr)
 	}
@@ -379,6 +451,13 @@ func (o *ListOptions) printGeneric(r *resource.Result) error {
 	return utilerrors.Reduce(utilerrors.Flatten(utilerrors.NewAggregate(errs)))
 }
 
+func (o *ListOptions) decorate(obj *unstructured.Unstructured) error {
+	if o.DecorateObject == nil {
+		return nil
+	}
+	return o.DecorateObject(obj)
+}
+
 func (o *ListOptions) PrintNotFoundResources() {
 	if !o.AllNamespaces {
 		fmt.Fprintf(o.ErrOut, "No %s found in %s namespace.\n", o.GVR.Resource, o.Namespace)
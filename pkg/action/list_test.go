@@ -21,6 +21,7 @@ package action
 
 import (
 	"bytes"
+	"fmt"
 	"net/http"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -214,6 +215,19 @@ metadata:
 			Expect(buf.String()).To(Equal(expected))
 		})
 
+		It("With -o template flag", func() {
+			_ = cmd.Flags().Set("output", "template={{.metadata.namespace}}/{{.metadata.name}}")
+			cmd.Run(cmd, []string{})
+			Expect(buf.String()).To(Equal("test/foo\ntest/bar\n"))
+		})
+
+		It("With -o template and --template flags", func() {
+			_ = cmd.Flags().Set("output", "template")
+			_ = cmd.Flags().Set("template", "{{.metadata.name}}")
+			cmd.Run(cmd, []string{})
+			Expect(buf.String()).To(Equal("foo\nbar\n"))
+		})
+
 		It("No resources found", func() {
 			tf := mockClient(&corev1.PodList{})
 			streams, _, buf, errbuf := genericiooptions.NewTestIOStreams()
@@ -225,4 +239,22 @@ metadata:
 			Expect(errbuf.String()).To(Equal("No pods found in test namespace.\n"))
 		})
 	})
+
+	It("truncate errors", func() {
+		var errs []error
+		for i := 0; i < 3; i++ {
+			errs = append(errs, fmt.Errorf("error %d", i))
+		}
+		Expect(truncateErrors(errs, 3)).Should(Equal(errs))
+
+		truncated := truncateErrors(errs, 2)
+		Expect(truncated).Should(HaveLen(3))
+		Expect(truncated[:2]).Should(Equal(errs[:2]))
+		Expect(truncated[2]).Should(MatchError("and 1 more error"))
+		Expect(errs[2]).Should(MatchError("error 2"))
+
+		truncated = truncateErrors(errs, 1)
+		Expect(truncated).Should(HaveLen(2))
+		Expect(truncated[1]).Should(MatchError("and 2 more errors"))
+	})
 })
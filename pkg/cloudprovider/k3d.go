@@ -97,7 +97,7 @@ func (p *localCloudProvider) Name() string {
 func (p *localCloudProvider) CreateK8sCluster(clusterInfo *K8sClusterInfo) error {
 	var err error
 
-	if p.cfg, err = buildClusterRunConfig(clusterInfo.ClusterName); err != nil {
+	if p.cfg, err = buildClusterRunConfig(clusterInfo.ClusterName, clusterInfo.K8sVersion); err != nil {
 		return err
 	}
 
@@ -209,9 +209,9 @@ func (p *localCloudProvider) GetClusterInfo() (*K8sClusterInfo, error) {
 }
 
 // buildClusterRunConfig returns the run-config for the k3d cluster
-func buildClusterRunConfig(clusterName string) (config.ClusterConfig, error) {
+func buildClusterRunConfig(clusterName string, k8sVersion string) (config.ClusterConfig, error) {
 	createOpts := buildClusterCreateOpts()
-	cluster, err := buildClusterConfig(clusterName, createOpts)
+	cluster, err := buildClusterConfig(clusterName, k3sImage(k8sVersion), createOpts)
 	if err != nil {
 		return config.ClusterConfig{}, err
 	}
@@ -239,7 +239,23 @@ func buildClusterCreateOpts() k3d.ClusterCreateOpts {
 	return clusterCreateOpts
 }
 
-func buildClusterConfig(clusterName string, opts k3d.ClusterCreateOpts) (k3d.Cluster, error) {
+// k3sImage returns the k3s image of the specified kubernetes version such as v1.26.4,
+// the default k3s image is returned if the version is empty.
+func k3sImage(k8sVersion string) string {
+	if k8sVersion == "" {
+		return K3sImage
+	}
+	tag := k8sVersion
+	if !strings.HasPrefix(tag, "v") {
+		tag = "v" + tag
+	}
+	if !strings.Contains(tag, "-k3s") {
+		tag += "-k3s1"
+	}
+	return "rancher/k3s:" + tag
+}
+
+func buildClusterConfig(clusterName string, image string, opts k3d.ClusterCreateOpts) (k3d.Cluster, error) {
 	var network = k3d.ClusterNetwork{
 		Name:     CliDockerNetwork,
 		External: false,
@@ -280,7 +296,7 @@ func buildClusterConfig(clusterName string, opts k3d.ClusterCreateOpts) (k3d.Clu
 	serverNode := k3d.Node{
 		Name:       k3dClient.GenerateNodeName(clusterConfig.Name, k3d.ServerRole, 0),
 		Role:       k3d.ServerRole,
-		Image:      K3sImage,
+		Image:      image,
 		ServerOpts: k3d.ServerOpts{},
 		Args:       []string{"--disable=metrics-server", "--disable=traefik", "--disable=local-storage"},
 	}
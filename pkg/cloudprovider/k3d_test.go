@@ -34,10 +34,16 @@ var _ = Describe("playground", func() {
 	)
 
 	It("k3d util function", func() {
-		config, err := buildClusterRunConfig("test")
+		config, err := buildClusterRunConfig("test", "")
 		Expect(err).ShouldNot(HaveOccurred())
 		Expect(config.Name).Should(ContainSubstring("test"))
 		Expect(setUpK3d(context.Background(), nil)).Should(HaveOccurred())
 		Expect(provider.DeleteK8sCluster(&K8sClusterInfo{ClusterName: clusterName})).Should(HaveOccurred())
 	})
+
+	It("k3s image", func() {
+		Expect(k3sImage("")).Should(Equal(K3sImage))
+		Expect(k3sImage("1.26.4")).Should(Equal("rancher/k3s:v1.26.4-k3s1"))
+		Expect(k3sImage("v1.26.4-k3s2")).Should(Equal("rancher/k3s:v1.26.4-k3s2"))
+	})
 })
@@ -76,6 +76,7 @@ type K8sClusterInfo struct {
 	Region        string `json:"region,omitempty"`
 	KubeConfig    string `json:"kube_config,omitempty"`
 	KbcliVersion  string `json:"kbcli_version,omitempty"`
+	K8sVersion    string `json:"k8s_version,omitempty"`
 }
 
 // IsValid checks if kubernetes cluster info is valid
@@ -303,6 +303,7 @@ func (o *ClusterObjects) GetClusterInfo() *ClusterInfo {
 		TerminationPolicy: string(c.Spec.TerminationPolicy),
 		Status:            string(c.Status.Phase),
 		CreatedTime:       util.TimeFormat(&c.CreationTimestamp),
+		Age:               util.GetHumanReadableDuration(c.CreationTimestamp, metav1.Time{}),
 		InternalEP:        types.None,
 		ExternalEP:        types.None,
 		Labels:            util.CombineLabels(c.Labels),
@@ -20,6 +20,7 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package cluster
 
 import (
+	"bytes"
 	"os"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -56,6 +57,13 @@ var _ = Describe("printer", func() {
 			Expect(printObjs(NewPrinter(os.Stdout, PrintWide, printerWithLabels), objs)).Should(Succeed())
 		})
 
+		It("print cluster info with age", func() {
+			out := &bytes.Buffer{}
+			Expect(printObjs(NewPrinter(out, PrintClusters, nil), objs)).Should(Succeed())
+			Expect(out.String()).Should(ContainSubstring("AGE"))
+			Expect(objs.GetClusterInfo().Age).ShouldNot(BeEmpty())
+		})
+
 		It("print component info", func() {
 			Expect(printObjs(NewPrinter(os.Stdout, PrintComponents, nil), objs)).Should(Succeed())
 		})
@@ -58,6 +58,7 @@ type ClusterInfo struct {
 	InternalEP        string `json:"internalEP,omitempty"`
 	ExternalEP        string `json:"externalEP,omitempty"`
 	CreatedTime       string `json:"age,omitempty"`
+	Age               string
 	Labels            string `json:"labels,omitempty"`
 }
 
@@ -23,11 +23,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math"
 	"path"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
@@ -37,6 +39,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 	discoverycli "k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -51,6 +54,7 @@ import (
 	"github.com/apecloud/kbcli/pkg/action"
 	"github.com/apecloud/kbcli/pkg/cmd/plugin"
 	"github.com/apecloud/kbcli/pkg/printer"
+	"github.com/apecloud/kbcli/pkg/spinner"
 	"github.com/apecloud/kbcli/pkg/types"
 	"github.com/apecloud/kbcli/pkg/util"
 )
@@ -88,6 +92,10 @@ type addonCmdOpts struct {
 
 	autoApprove bool
 	complete    func(self *addonCmdOpts, cmd *cobra.Command, args []string) error
+
+	// wait is used to wait for the addon to be enabled or disabled
+	wait    bool
+	timeout time.Duration
 }
 
 type addonListOpts struct {
@@ -97,6 +105,10 @@ type addonListOpts struct {
 	status []string
 	// listEngines is used to list engine addons
 	listEngines bool
+	// installed is used to list enabled addons only
+	installed bool
+	// available is used to list all addons in the addon indexes
+	available bool
 }
 
 // NewAddonCmd for addon functions
@@ -136,6 +148,8 @@ func newListCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Co
 	o.AddFlags(cmd, true)
 	cmd.Flags().StringArrayVar(&o.status, "status", []string{}, "Filter addons by status")
 	cmd.Flags().BoolVar(&o.listEngines, "engines", false, "List engine addons only")
+	cmd.Flags().BoolVar(&o.installed, "installed", false, "List enabled addons only")
+	cmd.Flags().BoolVar(&o.available, "available", false, "List all addons in the addon indexes and their status in the cluster")
 	return cmd
 }
 
@@ -220,6 +234,7 @@ func newEnableCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.
 			util.CheckErr(o.complete(o, cmd, args))
 			util.CheckErr(o.CmdComplete(cmd))
 			util.CheckErr(o.Run())
+			util.CheckErr(o.waitAddonPhase(cmd, extensionsv1alpha1.AddonEnabled))
 		},
 	}
 	cmd.Flags().StringArrayVar(&o.addonEnableFlags.MemorySets, "memory", []string{},
@@ -243,6 +258,7 @@ re-enable the addon (More info on how-to resize a PVC: https://kubernetes.io/doc
 	cmd.Flags().StringArrayVar(&o.addonEnableFlags.SetValues, "set", []string{},
 		"set values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2), it's only being processed if addon's type is helm.")
 	cmd.Flags().BoolVar(&o.addonEnableFlags.Force, "force", false, "ignoring the installable restrictions and forcefully enabling.")
+	o.addWaitFlags(cmd, "enabled")
 
 	o.PatchOptions.AddFlags(cmd)
 	return cmd
@@ -275,13 +291,20 @@ func newDisableCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra
 			util.CheckErr(o.complete(o, cmd, args))
 			util.CheckErr(o.CmdComplete(cmd))
 			util.CheckErr(o.Run())
+			util.CheckErr(o.waitAddonPhase(cmd, extensionsv1alpha1.AddonDisabled))
 		},
 	}
 	cmd.Flags().BoolVar(&o.autoApprove, "auto-approve", false, "Skip interactive approval before disabling addon")
+	o.addWaitFlags(cmd, "disabled")
 	o.PatchOptions.AddFlags(cmd)
 	return cmd
 }
 
+func (o *addonCmdOpts) addWaitFlags(cmd *cobra.Command, action string) {
+	cmd.Flags().BoolVar(&o.wait, "wait", true, fmt.Sprintf("Wait for the addon to be %s", action))
+	cmd.Flags().DurationVar(&o.timeout, "timeout", 5*time.Minute, fmt.Sprintf("Time to wait for the addon to be %s", action))
+}
+
 func (o *addonCmdOpts) init(args []string) error {
 	o.Names = args
 	if o.dynamic == nil {
@@ -320,6 +343,51 @@ func (o *addonCmdOpts) fetchAddonObj() error {
 	return nil
 }
 
+// waitAddonPhase waits for the addon to reach the specified phase after it is patched,
+// the addon status is only checked after the controller observed the latest spec.
+func (o *addonCmdOpts) waitAddonPhase(cmd *cobra.Command, phase extensionsv1alpha1.AddonPhase) error {
+	if !o.wait {
+		return nil
+	}
+	dryRun, err := cmdutil.GetDryRunStrategy(cmd)
+	if err != nil || dryRun != cmdutil.DryRunNone {
+		return err
+	}
+
+	name := o.Names[0]
+	s := spinner.New(o.Out, spinner.WithMessage(fmt.Sprintf("%-50s", fmt.Sprintf("Wait for addon %s to be %s", name, strings.ToLower(string(phase))))))
+	var addon *extensionsv1alpha1.Addon
+	checkPhase := func(ctx context.Context) (bool, error) {
+		obj, err := o.dynamic.Resource(types.AddonGVR()).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		addon = &extensionsv1alpha1.Addon{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, addon); err != nil {
+			return false, err
+		}
+		if addon.Status.ObservedGeneration < addon.Generation {
+			return false, nil
+		}
+		switch addon.Status.Phase {
+		case phase:
+			return true, nil
+		case extensionsv1alpha1.AddonFailed:
+			return false, fmt.Errorf("addon %s is failed", name)
+		}
+		return false, nil
+	}
+	if err = wait.PollUntilContextTimeout(context.Background(), 2*time.Second, o.timeout, true, checkPhase); err != nil {
+		s.Fail()
+		if addon != nil {
+			printer.PrintConditions(addon.Status.Conditions, o.Out)
+		}
+		return err
+	}
+	s.Success()
+	return nil
+}
+
 func (o *addonCmdOpts) validate() error {
 	if o.addonEnableFlags.Force {
 		return nil
@@ -413,6 +481,18 @@ func addonDescribeHandler(o *addonCmdOpts, cmd *cobra.Command, args []string) er
 	if len(o.addon.Spec.Installable.GetSelectorsStrings()) > 0 {
 		printer.PrintPairStringToLine("Auto-install selector", strings.Join(o.addon.Spec.Installable.GetSelectorsStrings(), ","), 0)
 	}
+	printer.PrintPairStringToLine("Version", getAddonVersion(&o.addon), 0)
+	if o.addon.Spec.Helm != nil {
+		printer.PrintPairStringToLine("Chart", o.addon.Spec.Helm.ChartLocationURL, 0)
+		if o.addon.Spec.Helm.ChartsImage != "" {
+			printer.PrintPairStringToLine("Charts image", o.addon.Spec.Helm.ChartsImage, 0)
+		}
+	}
+	clusterDefs, err := getAddonClusterDefs(o.dynamic, o.addon.Name)
+	if err != nil {
+		return err
+	}
+	printer.PrintPairStringToLine("Cluster definitions", strings.Join(clusterDefs, ","), 0)
 
 	switch o.addon.Status.Phase {
 	case extensionsv1alpha1.AddonEnabled:
@@ -474,7 +554,7 @@ func addonDescribeHandler(o *addonCmdOpts, cmd *cobra.Command, args []string) er
 		tbl.Print()
 	}
 
-	return nil
+	return printAddonClusters(o.dynamic, o.Out, clusterDefs)
 }
 
 func addonEnableDisableHandler(o *addonCmdOpts, cmd *cobra.Command, args []string) error {
@@ -800,8 +880,15 @@ func (o *addonCmdOpts) buildPatch(flags []*pflag.Flag) error {
 }
 
 func addonListRun(o *addonListOpts) error {
-	// if format is JSON or YAML, use default printer to output the result.
-	if o.Format == printer.JSON || o.Format == printer.YAML {
+	if o.installed && o.available {
+		return fmt.Errorf("--installed and --available can not be specified at the same time")
+	}
+	if o.available {
+		return addonListAvailable(o)
+	}
+
+	// if format is JSON, YAML or template, use default printer to output the result.
+	if o.Format == printer.JSON || o.Format == printer.YAML || o.Format == printer.Template {
 		_, err := o.Run()
 		return err
 	}
@@ -876,6 +963,11 @@ func addonListRun(o *addonListOpts) error {
 				continue
 			}
 
+			// only show enabled addons
+			if o.installed && addon.Status.Phase != extensionsv1alpha1.AddonEnabled {
+				continue
+			}
+
 			if o.Format == printer.Wide {
 				tbl.AddRow(addon.Name,
 					version,
@@ -911,6 +1003,62 @@ func addonListRun(o *addonListOpts) error {
 	return nil
 }
 
+// addonListAvailable lists all addons in the addon indexes, the status of the addon
+// installed in the cluster is also shown.
+func addonListAvailable(o *addonListOpts) error {
+	if o.Format == printer.JSON || o.Format == printer.YAML || o.Format == printer.Template {
+		return fmt.Errorf("--available only supports table or wide output format")
+	}
+	if err := addDefaultIndex(); err != nil {
+		return err
+	}
+	dir, err := util.GetCliAddonDir()
+	if err != nil {
+		return err
+	}
+	dynamic, err := o.Factory.DynamicClient()
+	if err != nil {
+		return err
+	}
+	addons, err := dynamic.Resource(types.AddonGVR()).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	status := make(map[string]string)
+	for _, addon := range addons.Items {
+		phase, _, _ := unstructured.NestedString(addon.Object, "status", "phase")
+		status[addon.GetName()] = phase
+	}
+	return printAvailableAddons(o.Out, dir, status)
+}
+
+// printAvailableAddons prints the addons in the indexes of the specified directory,
+// status is the phase of the addons installed in the cluster indexed by the addon name.
+func printAvailableAddons(out io.Writer, indexDir string, status map[string]string) error {
+	results, err := searchAddon("", indexDir)
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		fmt.Fprintln(out, "No addon found in the addon indexes, please update your index")
+		return nil
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].addon.Name < results[j].addon.Name
+	})
+	tbl := printer.NewTablePrinter(out)
+	tbl.AddRow("NAME", "VERSION", "INDEX", "STATUS")
+	for _, res := range results {
+		phase, ok := status[res.addon.Name]
+		if !ok {
+			phase = "NotInstalled"
+		}
+		tbl.AddRow(res.addon.Name, getAddonVersion(res.addon), res.index.name, phase)
+	}
+	tbl.Print()
+	return nil
+}
+
 func (o *addonCmdOpts) installAndUpgradePlugins() error {
 	if len(o.addon.Spec.CliPlugins) == 0 {
 		return nil
@@ -21,6 +21,7 @@ package addon
 
 import (
 	"net/http"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -34,6 +35,8 @@ import (
 	restfake "k8s.io/client-go/rest/fake"
 	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
 
+	extensionsv1alpha1 "github.com/apecloud/kubeblocks/apis/extensions/v1alpha1"
+
 	"github.com/apecloud/kbcli/pkg/action"
 	"github.com/apecloud/kbcli/pkg/testing"
 	"github.com/apecloud/kbcli/pkg/types"
@@ -111,6 +114,34 @@ var _ = Describe("Manage applications related to KubeBlocks", func() {
 		})
 	})
 
+	When("Wait for an addon to be enabled", func() {
+		It("should wait until the addon phase is enabled", func() {
+			o := &addonCmdOpts{
+				PatchOptions: action.NewPatchOptions(tf, streams, types.AddonGVR()),
+				Factory:      tf,
+				IOStreams:    streams,
+				timeout:      time.Second,
+			}
+			cmd := newEnableCmd(tf, streams)
+			addonObj := testing.FakeAddon("addon-test")
+			addonObj.Status.Phase = extensionsv1alpha1.AddonEnabled
+			o.Names = []string{addonObj.Name}
+			o.dynamic = testing.FakeDynamicClient(addonObj)
+
+			By("skip waiting if wait is false")
+			Expect(o.waitAddonPhase(cmd, extensionsv1alpha1.AddonDisabled)).Should(Succeed())
+
+			By("wait for the addon phase")
+			o.wait = true
+			Expect(o.waitAddonPhase(cmd, extensionsv1alpha1.AddonEnabled)).Should(Succeed())
+
+			By("return error if the addon is failed")
+			addonObj.Status.Phase = extensionsv1alpha1.AddonFailed
+			o.dynamic = testing.FakeDynamicClient(addonObj)
+			Expect(o.waitAddonPhase(cmd, extensionsv1alpha1.AddonEnabled)).Should(MatchError(ContainSubstring("failed")))
+		})
+	})
+
 	// When("Enable an addon", func() {
 	// 	It("should set addon.spec.install.enabled=true", func() {
 	// 		By("Checking install helm chart by fake helm action config")
@@ -83,6 +83,7 @@ func search(args []string, out io.Writer) error {
 }
 
 // searchAddon function will search for the addons with the specified name in the index of the specified directory and return them.
+// If the name is empty, all addons in the index will be returned.
 func searchAddon(name string, indexDir string) ([]searchResult, error) {
 	indexes, err := getAllIndexes(indexDir)
 	if err != nil {
@@ -113,7 +114,7 @@ func searchAddon(name string, indexDir string) ([]searchResult, error) {
 				if addon.Kind != "Addon" {
 					return filepath.SkipDir
 				}
-				if addon.Name == name {
+				if name == "" || addon.Name == name {
 					res = append(res, searchResult{i, addon})
 				}
 			}
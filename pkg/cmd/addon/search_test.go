@@ -77,4 +77,15 @@ var _ = Describe("search test", func() {
 		}
 	})
 
+	It("test list available addons", func() {
+		Expect(printAvailableAddons(out, testIndexDir, map[string]string{testAddonName: "Enabled"})).Should(Succeed())
+		Expect(out.String()).Should(ContainSubstring("0.8.0-alpha.6"))
+		Expect(out.String()).Should(ContainSubstring("Enabled"))
+		Expect(out.String()).ShouldNot(ContainSubstring("NotInstalled"))
+
+		out.Reset()
+		Expect(printAvailableAddons(out, testIndexDir, nil)).Should(Succeed())
+		Expect(out.String()).Should(ContainSubstring("NotInstalled"))
+	})
+
 })
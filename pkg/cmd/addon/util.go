@@ -78,3 +78,46 @@ func CheckAddonUsedByCluster(dynamic dynamic.Interface, addons []string, in io.R
 	}
 	return nil
 }
+
+// getAddonClusterDefs returns the names of the cluster definitions provided by the addon.
+func getAddonClusterDefs(dynamic dynamic.Interface, addon string) ([]string, error) {
+	list, err := dynamic.Resource(types.ClusterDefGVR()).List(context.Background(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", types.AddonNameLabelKey, addon),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, item := range list.Items {
+		names = append(names, item.GetName())
+	}
+	return names, nil
+}
+
+// printAddonClusters prints the clusters created by the cluster definitions of the addon.
+func printAddonClusters(dynamic dynamic.Interface, out io.Writer, clusterDefs []string) error {
+	if len(clusterDefs) == 0 {
+		return nil
+	}
+	list, err := dynamic.Resource(types.ClusterGVR()).List(context.Background(), metav1.ListOptions{
+		LabelSelector: util.BuildClusterLabel("", clusterDefs),
+	})
+	if err != nil {
+		return err
+	}
+	if len(list.Items) == 0 {
+		return nil
+	}
+	fmt.Fprintln(out, "\nClusters")
+	tbl := printer.NewTablePrinter(out)
+	tbl.SetHeader("NAMESPACE", "NAME", "CLUSTER-DEFINITION", "STATUS")
+	for _, item := range list.Items {
+		var cluster v1alpha1.Cluster
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &cluster); err != nil {
+			return err
+		}
+		tbl.AddRow(cluster.Namespace, cluster.Name, cluster.Spec.ClusterDefRef, cluster.Status.Phase)
+	}
+	tbl.Print()
+	return nil
+}
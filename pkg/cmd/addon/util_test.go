@@ -20,6 +20,7 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package addon
 
 import (
+	"bytes"
 	"net/http"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -72,4 +73,19 @@ var _ = Describe("addon util test", func() {
 		Expect(CheckAddonUsedByCluster(tf.FakeDynamicClient, []string{fakeAddonName}, streams.In)).Should(HaveOccurred())
 	})
 
+	It("test get clusters using the addon", func() {
+		dynamic := testing.FakeDynamicClient(testing.FakeClusterDef(), testing.FakeCluster(testing.ClusterName, testing.Namespace))
+		clusterDefs, err := getAddonClusterDefs(dynamic, fakeAddonName)
+		Expect(err).Should(Succeed())
+		Expect(clusterDefs).Should(Equal([]string{testing.ClusterDefName}))
+
+		out := &bytes.Buffer{}
+		Expect(printAddonClusters(dynamic, out, clusterDefs)).Should(Succeed())
+		Expect(out.String()).Should(ContainSubstring(testing.ClusterName))
+
+		out.Reset()
+		Expect(printAddonClusters(dynamic, out, nil)).Should(Succeed())
+		Expect(out.String()).Should(BeEmpty())
+	})
+
 })
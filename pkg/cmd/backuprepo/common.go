@@ -63,7 +63,12 @@ func tryConvertLegacyStorageProvider(dynamic dynamic.Interface, name string) (*d
 		}
 		return nil, err
 	}
+	return ConvertLegacyStorageProvider(provider), nil
+}
 
+// ConvertLegacyStorageProvider converts the storage provider of the legacy storage.kubeblocks.io API
+// to the one of the dataprotection.kubeblocks.io API.
+func ConvertLegacyStorageProvider(provider *storagev1alpha1.StorageProvider) *dpv1alpha1.StorageProvider {
 	var parametersSchema *dpv1alpha1.ParametersSchema
 	if provider.Spec.ParametersSchema != nil {
 		parametersSchema = &dpv1alpha1.ParametersSchema{
@@ -72,7 +77,7 @@ func tryConvertLegacyStorageProvider(dynamic dynamic.Interface, name string) (*d
 		}
 	}
 
-	newProvider := &dpv1alpha1.StorageProvider{
+	return &dpv1alpha1.StorageProvider{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        provider.Name,
 			Labels:      provider.Labels,
@@ -86,8 +91,11 @@ func tryConvertLegacyStorageProvider(dynamic dynamic.Interface, name string) (*d
 			DatasafedConfigTemplate:       provider.Spec.DatasafedConfigTemplate,
 			ParametersSchema:              parametersSchema,
 		},
+		Status: dpv1alpha1.StorageProviderStatus{
+			Phase:      dpv1alpha1.StorageProviderPhase(provider.Status.Phase),
+			Conditions: provider.Status.Conditions,
+		},
 	}
-	return newProvider, nil
 }
 
 func getStorageProvider(dynamic dynamic.Interface, name string) (*dpv1alpha1.StorageProvider, error) {
@@ -134,6 +134,7 @@ func newCreateCommand(o *createOptions, f cmdutil.Factory, streams genericioopti
 		Short:   "Create a backup repository",
 		Example: backupRepoCreateExamples,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			util.CheckErr(flags.ParseInheritedFlags(cmd, args))
 			util.CheckErr(o.init(f))
 			err := o.parseProviderFlags(cmd, args, f)
 			if errors.Is(err, pflag.ErrHelp) {
@@ -82,8 +82,8 @@ func (o *listBackupRepoOptions) Complete() error {
 }
 
 func printBackupRepoList(o *listBackupRepoOptions) error {
-	// if format is JSON or YAML, use default printer to output the result.
-	if o.Format == printer.JSON || o.Format == printer.YAML {
+	// if format is JSON, YAML or template, use default printer to output the result.
+	if o.Format == printer.JSON || o.Format == printer.YAML || o.Format == printer.Template {
 		_, err := o.Run()
 		return err
 	}
@@ -90,6 +90,7 @@ func newUpdateCommand(o *updateOptions, f cmdutil.Factory, streams genericioopti
 		ValidArgsFunction: util.ResourceNameCompletionFunc(f, types.BackupRepoGVR()),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmdutil.BehaviorOnFatal(printer.FatalWithRedColor)
+			util.CheckErr(flags.ParseInheritedFlags(cmd, args))
 			util.CheckErr(o.init(f))
 			err := o.parseFlags(cmd, args, f)
 			if errors.Is(err, pflag.ErrHelp) {
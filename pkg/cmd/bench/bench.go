@@ -0,0 +1,462 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bench
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+	"k8s.io/utils/pointer"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+
+	"github.com/apecloud/kbcli/pkg/cluster"
+	"github.com/apecloud/kbcli/pkg/printer"
+	"github.com/apecloud/kbcli/pkg/spinner"
+	"github.com/apecloud/kbcli/pkg/types"
+	"github.com/apecloud/kbcli/pkg/util"
+)
+
+const (
+	sysbenchType = "sysbench"
+	pgbenchType  = "pgbench"
+	tpccType     = "tpcc"
+
+	benchTypeLabelKey = "bench.kubeblocks.io/type"
+
+	// the environment variables of the benchmark container to reference the cluster credential
+	userEnvName     = "BENCH_USER"
+	passwordEnvName = "BENCH_PASSWORD"
+
+	// benchJobTTL is the seconds to keep the finished benchmark job
+	benchJobTTL int32 = 3600
+
+	// maxJobNameLen is the max length of the job name, which is also the value of the job-name
+	// label of the benchmark pod and should not exceed the length limit of a label value.
+	maxJobNameLen = validation.LabelValueMaxLength
+)
+
+var (
+	benchTypes = []string{sysbenchType, pgbenchType, tpccType}
+
+	defaultImages = map[string]string{
+		sysbenchType: "zyclonite/sysbench:1.0.21",
+		pgbenchType:  "postgres:14",
+		tpccType:     "pingcap/go-tpc:v1.0.9",
+	}
+
+	defaultDatabases = map[string]string{
+		sysbenchType: "sbtest",
+		pgbenchType:  "postgres",
+		tpccType:     "tpcc",
+	}
+
+	// summaryPatterns are the patterns to extract the summary from the benchmark output,
+	// the first submatch is the value of the summary item.
+	summaryPatterns = map[string][]summaryPattern{
+		sysbenchType: {
+			{"Transactions", regexp.MustCompile(`transactions:\s+\d+\s+\((\S+) per sec\.\)`)},
+			{"Queries", regexp.MustCompile(`queries:\s+\d+\s+\((\S+) per sec\.\)`)},
+			{"Avg Latency (ms)", regexp.MustCompile(`avg:\s+(\S+)`)},
+			{"95th Latency (ms)", regexp.MustCompile(`95th percentile:\s+(\S+)`)},
+		},
+		pgbenchType: {
+			{"TPS", regexp.MustCompile(`tps = (\S+)`)},
+			{"Avg Latency (ms)", regexp.MustCompile(`latency average = (\S+) ms`)},
+			{"Transactions", regexp.MustCompile(`number of transactions actually processed: (\d+)`)},
+		},
+		tpccType: {
+			{"tpmC", regexp.MustCompile(`tpmC: ([\d.]+)`)},
+			{"tpmTotal", regexp.MustCompile(`tpmTotal: ([\d.]+)`)},
+			{"Efficiency", regexp.MustCompile(`efficiency: ([\d.]+%)`)},
+		},
+	}
+)
+
+var benchExample = templates.Examples(`
+	# run sysbench against the cluster mycluster for 60 seconds
+	kbcli bench --cluster mycluster
+
+	# run pgbench against the cluster pgcluster with 8 threads for 5 minutes
+	kbcli bench --cluster pgcluster --type pgbench --threads 8 --duration 300
+
+	# run tpcc against the cluster mycluster with 4 warehouses
+	kbcli bench --cluster mycluster --type tpcc --tables 4`)
+
+type summaryPattern struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+type benchOptions struct {
+	factory   cmdutil.Factory
+	client    kubernetes.Interface
+	dynamic   dynamic.Interface
+	namespace string
+
+	Cluster   string
+	Component string
+	Type      string
+	Database  string
+	Image     string
+	Duration  int
+	Threads   int
+	Tables    int
+	TableSize int
+
+	genericiooptions.IOStreams
+}
+
+// benchTarget is the endpoint and the credential of the cluster to run the benchmark against.
+type benchTarget struct {
+	host   string
+	port   int32
+	secret string
+}
+
+func NewBenchCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &benchOptions{factory: f, IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:     "bench",
+		Short:   "Run a benchmark against a cluster.",
+		Example: benchExample,
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.BehaviorOnFatal(printer.FatalWithRedColor)
+			util.CheckErr(o.complete())
+			util.CheckErr(o.validate())
+			util.CheckErr(o.run())
+		},
+	}
+	cmd.Flags().StringVar(&o.Cluster, "cluster", "", "The cluster to run the benchmark against")
+	cmd.Flags().StringVar(&o.Component, "component", "", "The component of the cluster to run the benchmark against, default to the first component")
+	cmd.Flags().StringVar(&o.Type, "type", sysbenchType, fmt.Sprintf("The benchmark type, one of [%s]", strings.Join(benchTypes, ", ")))
+	cmd.Flags().StringVar(&o.Database, "database", "", "The database to run the benchmark in, it must exist except for tpcc. Default to sbtest for sysbench, postgres for pgbench and tpcc for tpcc")
+	cmd.Flags().StringVar(&o.Image, "image", "", "The image of the benchmark tool, default to the image of the benchmark type")
+	cmd.Flags().IntVar(&o.Duration, "duration", 60, "The seconds to run the benchmark")
+	cmd.Flags().IntVar(&o.Threads, "threads", 4, "The number of threads to run the benchmark")
+	cmd.Flags().IntVar(&o.Tables, "tables", 10, "The number of tables for sysbench, the scale factor for pgbench or the number of warehouses for tpcc")
+	cmd.Flags().IntVar(&o.TableSize, "table-size", 10000, "The number of rows of each table, only for sysbench")
+	util.CheckErr(cmd.MarkFlagRequired("cluster"))
+	util.CheckErr(cmd.RegisterFlagCompletionFunc("cluster", util.ResourceNameCompletionFunc(f, types.ClusterGVR())))
+	util.CheckErr(cmd.RegisterFlagCompletionFunc("type", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return benchTypes, cobra.ShellCompDirectiveNoFileComp
+	}))
+	return cmd
+}
+
+func (o *benchOptions) complete() error {
+	var err error
+	if o.namespace, _, err = o.factory.ToRawKubeConfigLoader().Namespace(); err != nil {
+		return err
+	}
+	if o.client, err = o.factory.KubernetesClientSet(); err != nil {
+		return err
+	}
+	if o.dynamic, err = o.factory.DynamicClient(); err != nil {
+		return err
+	}
+	if o.Image == "" {
+		o.Image = defaultImages[o.Type]
+	}
+	if o.Database == "" {
+		o.Database = defaultDatabases[o.Type]
+	}
+	return nil
+}
+
+func (o *benchOptions) validate() error {
+	if o.Cluster == "" {
+		return fmt.Errorf("missing cluster name, please specify it by --cluster")
+	}
+	if !slices.Contains(benchTypes, o.Type) {
+		return fmt.Errorf("unsupported benchmark type %s, supported types are [%s]", o.Type, strings.Join(benchTypes, ", "))
+	}
+	if o.Duration <= 0 || o.Threads <= 0 || o.Tables <= 0 || o.TableSize <= 0 {
+		return fmt.Errorf("--duration, --threads, --tables and --table-size must be greater than 0")
+	}
+	return nil
+}
+
+func (o *benchOptions) run() error {
+	target, err := o.getTarget()
+	if err != nil {
+		return err
+	}
+	job, err := o.client.BatchV1().Jobs(o.namespace).Create(context.TODO(), o.buildJob(target), metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "Benchmark job %s is created\n", job.Name)
+
+	pod, err := o.waitPodStarted(job.Name)
+	if err != nil {
+		return err
+	}
+
+	// stream the benchmark output and keep it to extract the summary
+	output := &bytes.Buffer{}
+	stream, err := o.client.CoreV1().Pods(o.namespace).GetLogs(pod.Name, &corev1.PodLogOptions{Follow: true}).Stream(context.TODO())
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	if _, err = io.Copy(io.MultiWriter(o.Out, output), stream); err != nil {
+		return err
+	}
+
+	// the phase of the pod may not be updated yet when the log stream ends
+	phase, err := o.waitPodCompleted(pod.Name)
+	if err != nil {
+		return err
+	}
+	if phase == corev1.PodFailed {
+		return fmt.Errorf("benchmark job %s failed, run \"kubectl logs job/%s -n %s\" to view the details", job.Name, job.Name, o.namespace)
+	}
+	printSummary(o.Out, o.Type, output.String())
+	return nil
+}
+
+// getTarget gets the service and the credential secret of the cluster component.
+func (o *benchOptions) getTarget() (*benchTarget, error) {
+	c, err := cluster.GetClusterByName(o.dynamic, o.Cluster, o.namespace)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.Spec.ComponentSpecs) == 0 {
+		return nil, fmt.Errorf("cluster %s has no component", o.Cluster)
+	}
+	var comp *appsv1alpha1.ClusterComponentSpec
+	if o.Component == "" {
+		comp = &c.Spec.ComponentSpecs[0]
+	} else if comp = c.Spec.GetComponentByName(o.Component); comp == nil {
+		return nil, fmt.Errorf("component %s is not found in cluster %s", o.Component, o.Cluster)
+	}
+
+	listOpts := metav1.ListOptions{LabelSelector: util.BuildLabelSelectorByNames("", []string{o.Cluster})}
+	svcList, err := o.client.CoreV1().Services(o.namespace).List(context.TODO(), listOpts)
+	if err != nil {
+		return nil, err
+	}
+	internalSvcs, _ := cluster.GetComponentServices(svcList, comp)
+	if len(internalSvcs) == 0 || len(internalSvcs[0].Spec.Ports) == 0 {
+		return nil, fmt.Errorf("failed to find the service of component %s in cluster %s", comp.Name, o.Cluster)
+	}
+
+	secrets, err := o.client.CoreV1().Secrets(o.namespace).List(context.TODO(), listOpts)
+	if err != nil {
+		return nil, err
+	}
+	secret := getCredentialSecret(secrets)
+	if secret == "" {
+		return nil, fmt.Errorf("failed to find the username and password of cluster %s", o.Cluster)
+	}
+	return &benchTarget{
+		host:   internalSvcs[0].Name,
+		port:   internalSvcs[0].Spec.Ports[0].Port,
+		secret: secret,
+	}, nil
+}
+
+// getCredentialSecret returns the name of the connection credential secret, fallback to
+// the first secret with username and password.
+func getCredentialSecret(secrets *corev1.SecretList) string {
+	var name string
+	for _, s := range secrets.Items {
+		if _, ok := s.Data["username"]; !ok {
+			continue
+		}
+		if _, ok := s.Data["password"]; !ok {
+			continue
+		}
+		if strings.Contains(s.Name, "conn-credential") {
+			return s.Name
+		}
+		if name == "" {
+			name = s.Name
+		}
+	}
+	return name
+}
+
+// buildJob builds the benchmark job, the credential is referenced from the cluster secret.
+func (o *benchOptions) buildJob(target *benchTarget) *batchv1.Job {
+	secretEnv := func(name, key string) corev1.EnvVar {
+		return corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: target.secret},
+					Key:                  key,
+				},
+			},
+		}
+	}
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      o.buildJobName(),
+			Namespace: o.namespace,
+			Labels: map[string]string{
+				benchTypeLabelKey:             o.Type,
+				constant.AppManagedByLabelKey: "kbcli",
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            pointer.Int32(0),
+			TTLSecondsAfterFinished: pointer.Int32(benchJobTTL),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{{
+						Name:    "bench",
+						Image:   o.Image,
+						Command: []string{"sh", "-c", o.buildCommand(target)},
+						Env: []corev1.EnvVar{
+							secretEnv(userEnvName, "username"),
+							secretEnv(passwordEnvName, "password"),
+						},
+					}},
+				},
+			},
+		},
+	}
+}
+
+// buildJobName builds the job name in the format of <cluster>-<type>-<random>, the cluster name is
+// truncated if the job name exceeds maxJobNameLen.
+func (o *benchOptions) buildJobName() string {
+	suffix := fmt.Sprintf("-%s-%s", o.Type, util.RandRFC1123String(5))
+	prefix := o.Cluster
+	if len(prefix)+len(suffix) > maxJobNameLen {
+		prefix = strings.TrimRight(prefix[:maxJobNameLen-len(suffix)], "-.")
+	}
+	return prefix + suffix
+}
+
+// buildCommand builds the shell command to prepare the data, run the benchmark and clean up, the
+// credentials are quoted since the generated password may contain the special characters of shell.
+func (o *benchOptions) buildCommand(target *benchTarget) string {
+	switch o.Type {
+	case pgbenchType:
+		conn := fmt.Sprintf("-h %s -p %d -U \"$%s\" %s", target.host, target.port, userEnvName, o.Database)
+		return fmt.Sprintf("export PGPASSWORD=\"$%s\" && pgbench -i -s %d %s && pgbench -c %d -j %d -T %d %s",
+			passwordEnvName, o.Tables, conn, o.Threads, o.Threads, o.Duration, conn)
+	case tpccType:
+		args := fmt.Sprintf("tpcc -H %s -P %d -U \"$%s\" -p \"$%s\" -D %s --warehouses %d -T %d",
+			target.host, target.port, userEnvName, passwordEnvName, o.Database, o.Tables, o.Threads)
+		return fmt.Sprintf("go-tpc %s prepare && go-tpc %s --time %ds run", args, args, o.Duration)
+	default:
+		args := fmt.Sprintf("oltp_read_write --db-driver=mysql --mysql-host=%s --mysql-port=%d --mysql-user=\"$%s\" --mysql-password=\"$%s\" --mysql-db=%s --tables=%d --table-size=%d --threads=%d",
+			target.host, target.port, userEnvName, passwordEnvName, o.Database, o.Tables, o.TableSize, o.Threads)
+		return fmt.Sprintf("sysbench %s prepare && sysbench %s --time=%d --report-interval=10 run; rc=$?; sysbench %s cleanup; exit $rc",
+			args, args, o.Duration, args)
+	}
+}
+
+// waitPodStarted waits for the pod of the benchmark job to leave the pending phase.
+func (o *benchOptions) waitPodStarted(jobName string) (*corev1.Pod, error) {
+	var pod *corev1.Pod
+	s := spinner.New(o.Out, spinner.WithMessage(fmt.Sprintf("%-50s", "Wait for the benchmark pod to start")))
+	err := wait.PollUntilContextTimeout(context.Background(), 2*time.Second, 10*time.Minute, true, func(ctx context.Context) (bool, error) {
+		pods, err := o.client.CoreV1().Pods(o.namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", batchv1.JobNameLabel, jobName),
+		})
+		if err != nil {
+			return false, err
+		}
+		for i := range pods.Items {
+			if pods.Items[i].Status.Phase != corev1.PodPending {
+				pod = &pods.Items[i]
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		s.Fail()
+		return nil, err
+	}
+	s.Success()
+	return pod, nil
+}
+
+// waitPodCompleted waits for the benchmark pod to reach the Succeeded or Failed phase, and returns the phase.
+func (o *benchOptions) waitPodCompleted(podName string) (corev1.PodPhase, error) {
+	var phase corev1.PodPhase
+	err := wait.PollUntilContextTimeout(context.Background(), time.Second, 2*time.Minute, true, func(ctx context.Context) (bool, error) {
+		pod, err := o.client.CoreV1().Pods(o.namespace).Get(ctx, podName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		phase = pod.Status.Phase
+		return phase == corev1.PodSucceeded || phase == corev1.PodFailed, nil
+	})
+	if err != nil {
+		return phase, fmt.Errorf("failed to wait for the benchmark pod %s to complete: %v", podName, err)
+	}
+	return phase, nil
+}
+
+// parseSummary extracts the summary items from the benchmark output, the last match is used
+// if an item is reported more than once.
+func parseSummary(benchType, output string) map[string]string {
+	summary := make(map[string]string)
+	for _, p := range summaryPatterns[benchType] {
+		matches := p.pattern.FindAllStringSubmatch(output, -1)
+		if len(matches) > 0 {
+			summary[p.name] = matches[len(matches)-1][1]
+		}
+	}
+	return summary
+}
+
+func printSummary(out io.Writer, benchType, output string) {
+	summary := parseSummary(benchType, output)
+	if len(summary) == 0 {
+		return
+	}
+	fmt.Fprintln(out, "\nSummary:")
+	tbl := printer.NewTablePrinter(out)
+	tbl.SetHeader("ITEM", "VALUE")
+	for _, p := range summaryPatterns[benchType] {
+		if v, ok := summary[p.name]; ok {
+			tbl.AddRow(p.name, v)
+		}
+	}
+	tbl.Print()
+}
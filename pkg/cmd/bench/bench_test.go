@@ -0,0 +1,165 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bench
+
+import (
+	"bytes"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	clientfake "k8s.io/client-go/rest/fake"
+	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
+
+	clitesting "github.com/apecloud/kbcli/pkg/testing"
+)
+
+var _ = Describe("bench", func() {
+	var (
+		streams genericiooptions.IOStreams
+		out     *bytes.Buffer
+		tf      *cmdtesting.TestFactory
+	)
+
+	BeforeEach(func() {
+		streams, _, out, _ = genericiooptions.NewTestIOStreams()
+		tf = clitesting.NewTestFactory(clitesting.Namespace)
+		tf.Client = &clientfake.RESTClient{}
+	})
+
+	AfterEach(func() {
+		tf.Cleanup()
+	})
+
+	newOptions := func() *benchOptions {
+		return &benchOptions{
+			factory:   tf,
+			IOStreams: streams,
+			Cluster:   clitesting.ClusterName,
+			Type:      sysbenchType,
+			Duration:  60,
+			Threads:   4,
+			Tables:    10,
+			TableSize: 10000,
+		}
+	}
+
+	It("bench command", func() {
+		cmd := NewBenchCmd(tf, streams)
+		Expect(cmd).ShouldNot(BeNil())
+		Expect(cmd.Flag("cluster")).ShouldNot(BeNil())
+	})
+
+	It("complete and validate", func() {
+		o := newOptions()
+		o.Type = pgbenchType
+		Expect(o.complete()).Should(Succeed())
+		Expect(o.Image).Should(Equal(defaultImages[pgbenchType]))
+		Expect(o.Database).Should(Equal(defaultDatabases[pgbenchType]))
+		Expect(o.validate()).Should(Succeed())
+
+		o.Type = "unknown"
+		Expect(o.validate()).Should(MatchError(ContainSubstring("unsupported benchmark type")))
+
+		o.Type = sysbenchType
+		o.Threads = 0
+		Expect(o.validate()).Should(HaveOccurred())
+
+		o.Cluster = ""
+		Expect(o.validate()).Should(MatchError(ContainSubstring("missing cluster name")))
+	})
+
+	It("build benchmark job", func() {
+		o := newOptions()
+		o.namespace = clitesting.Namespace
+		o.client = clitesting.FakeClientSet(clitesting.FakeServices(), clitesting.FakeSecrets(clitesting.Namespace, clitesting.ClusterName))
+		o.dynamic = clitesting.FakeDynamicClient(clitesting.FakeCluster(clitesting.ClusterName, clitesting.Namespace))
+		target, err := o.getTarget()
+		Expect(err).Should(Succeed())
+		Expect(target.secret).Should(Equal(clitesting.SecretName))
+		Expect(target.port).Should(BeEquivalentTo(3306))
+
+		o.Component = "not-exist"
+		_, err = o.getTarget()
+		Expect(err).Should(HaveOccurred())
+
+		for _, t := range benchTypes {
+			o.Type = t
+			o.Image = defaultImages[t]
+			job := o.buildJob(target)
+			Expect(job.Namespace).Should(Equal(clitesting.Namespace))
+			Expect(job.Labels[benchTypeLabelKey]).Should(Equal(t))
+			container := job.Spec.Template.Spec.Containers[0]
+			Expect(container.Image).Should(Equal(defaultImages[t]))
+			Expect(container.Command[2]).Should(ContainSubstring(target.host))
+			Expect(container.Command[2]).ShouldNot(ContainSubstring("test-password"))
+			Expect(container.Command[2]).Should(ContainSubstring(`"$` + passwordEnvName + `"`))
+			Expect(container.Env[1].ValueFrom.SecretKeyRef.Name).Should(Equal(clitesting.SecretName))
+			Expect(job.Spec.Template.Spec.RestartPolicy).Should(Equal(corev1.RestartPolicyNever))
+		}
+
+		By("truncate the cluster name in the job name")
+		o.Cluster = strings.Repeat("a", 60)
+		name := o.buildJobName()
+		Expect(len(name)).Should(BeNumerically("<=", maxJobNameLen))
+		Expect(name).Should(HavePrefix("aaaa"))
+		Expect(name).Should(ContainSubstring("-" + o.Type + "-"))
+	})
+
+	It("wait for the benchmark pod to complete", func() {
+		o := newOptions()
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "bench-pod", Namespace: clitesting.Namespace}}
+		pod.Status.Phase = corev1.PodFailed
+		o.namespace = clitesting.Namespace
+		o.client = clitesting.FakeClientSet(pod)
+		Expect(o.waitPodCompleted(pod.Name)).Should(Equal(corev1.PodFailed))
+	})
+
+	It("print summary", func() {
+		sysbenchOutput := `
+SQL statistics:
+    transactions:                        12345  (205.66 per sec.)
+    queries:                             246900 (4113.28 per sec.)
+Latency (ms):
+         avg:                                   19.44
+         95th percentile:                       25.28
+`
+		summary := parseSummary(sysbenchType, sysbenchOutput)
+		Expect(summary).Should(HaveLen(4))
+		Expect(summary["Transactions"]).Should(Equal("205.66"))
+		Expect(summary["95th Latency (ms)"]).Should(Equal("25.28"))
+
+		pgbenchOutput := `
+number of transactions actually processed: 61890
+latency average = 3.876 ms
+tps = 1031.502 (without initial connection time)
+`
+		summary = parseSummary(pgbenchType, pgbenchOutput)
+		Expect(summary["TPS"]).Should(Equal("1031.502"))
+		Expect(summary["Transactions"]).Should(Equal("61890"))
+
+		printSummary(out, tpccType, "tpmC: 100.0, efficiency: 77.8%\ntpmC: 416.9, tpmTotal: 925.3, efficiency: 3241.9%")
+		Expect(out.String()).Should(ContainSubstring("416.9"))
+		Expect(out.String()).ShouldNot(ContainSubstring("100.0"))
+	})
+})
@@ -21,12 +21,16 @@ package cmd
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/rest"
 	cliflag "k8s.io/component-base/cli/flag"
 	"k8s.io/klog/v2"
 	kccmd "k8s.io/kubectl/pkg/cmd"
@@ -40,9 +44,12 @@ import (
 
 	"github.com/apecloud/kbcli/pkg/cmd/addon"
 	"github.com/apecloud/kbcli/pkg/cmd/backuprepo"
+	"github.com/apecloud/kbcli/pkg/cmd/bench"
 	"github.com/apecloud/kbcli/pkg/cmd/cluster"
 	"github.com/apecloud/kbcli/pkg/cmd/clusterdefinition"
 	"github.com/apecloud/kbcli/pkg/cmd/clusterversion"
+	"github.com/apecloud/kbcli/pkg/cmd/completion"
+	"github.com/apecloud/kbcli/pkg/cmd/config"
 	"github.com/apecloud/kbcli/pkg/cmd/dashboard"
 	"github.com/apecloud/kbcli/pkg/cmd/dataprotection"
 	"github.com/apecloud/kbcli/pkg/cmd/kubeblocks"
@@ -50,13 +57,18 @@ import (
 	"github.com/apecloud/kbcli/pkg/cmd/playground"
 	"github.com/apecloud/kbcli/pkg/cmd/plugin"
 	"github.com/apecloud/kbcli/pkg/cmd/report"
+	"github.com/apecloud/kbcli/pkg/cmd/storageprovider"
 	"github.com/apecloud/kbcli/pkg/cmd/version"
 	"github.com/apecloud/kbcli/pkg/types"
 	"github.com/apecloud/kbcli/pkg/util"
+	"github.com/apecloud/kbcli/pkg/util/flags"
 )
 
 const (
 	cliName = "kbcli"
+
+	noColorFlag = "no-color"
+	profileFlag = "profile"
 )
 
 func init() {
@@ -72,6 +84,12 @@ func init() {
 		fmt.Println("Failed to add kbcli bin dir to PATH:", err)
 	}
 
+	// run the persistent hooks of all parents rather than only the nearest one, otherwise the
+	// global flags such as --profile are not applied to the commands with their own hooks,
+	// such as addon install and plugin. It is a global setting of cobra, so it is set once
+	// here instead of in the constructor of the root command.
+	cobra.EnableTraverseRunHooks = true
+
 	// when the kubernetes cluster is not ready, the runtime will output the error
 	// message like "couldn't get resource list for", we ignore it
 	utilruntime.ErrorHandlers[0] = func(err error) {
@@ -116,6 +134,12 @@ func NewDefaultCliCmd() *cobra.Command {
 }
 
 func NewCliCmd() *cobra.Command {
+	var (
+		noColor  bool
+		profile  string
+		timeout  time.Duration
+		deadline time.Time
+	)
 	cmd := &cobra.Command{
 		Use:   cliName,
 		Short: "KubeBlocks CLI.",
@@ -141,18 +165,51 @@ A Command Line Interface for KubeBlocks`,
 			if cmd.Name() == cobra.ShellCompRequestCmd {
 				kcplugin.SetupPluginCompletion(cmd, args)
 			}
+			if err := config.ApplyCurrentContext(cmd); err != nil {
+				return err
+			}
+			// the commands which disable the flag parsing, such as cluster custom-ops, parse their
+			// flags later in Run, so the profile is read from the args here
+			if cmd.DisableFlagParsing {
+				var err error
+				if profile, err = parseProfileFlag(args); err != nil {
+					return err
+				}
+			}
+			// the profile takes precedence over the current context
+			if err := config.ApplyProfile(cmd, profile); err != nil {
+				return err
+			}
+			// color is disabled by default if NO_COLOR is set or stdout is not a terminal
+			if noColor {
+				color.NoColor = true
+			}
+			deadline = setTimeoutContext(cmd, timeout)
 			return nil
 		},
 	}
 
+	// use the completion command of kbcli instead of the default one of cobra
+	cmd.CompletionOptions.DisableDefaultCmd = true
+
 	// Start from this point we get warnings on flags that contain "_" separators
 	// when adding them with hyphen instead of the original name.
 	cmd.SetGlobalNormalizationFunc(cliflag.WarnWordSepNormalizeFunc)
 
 	flags := cmd.PersistentFlags()
+	flags.BoolVar(&noColor, noColorFlag, false, "Disable the colored output, it is also disabled if the NO_COLOR environment variable is set or the output is not a terminal")
+	flags.StringVar(&profile, profileFlag, "", "The name of the kbcli profile to use, its kubeconfig, context, namespace and output are used unless the flags are specified, run \"kbcli profile list\" to show all profiles")
+	flags.DurationVar(&timeout, timeoutFlag, 0, "The length of time to wait before giving up the whole command, such as 1m, zero means no timeout. It bounds all requests to the API server and stops --watch as well, and it is ignored by the commands which have their own --timeout flag")
 
 	// add kubernetes flags like kubectl
 	kubeConfigFlags := util.NewConfigFlagNoWarnings()
+	wrapConfig := kubeConfigFlags.WrapConfigFn
+	kubeConfigFlags.WrapConfigFn = func(c *rest.Config) *rest.Config {
+		c.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return &deadlineRoundTripper{deadline: func() time.Time { return deadline }, rt: rt}
+		})
+		return wrapConfig(c)
+	}
 	kubeConfigFlags.AddFlags(flags)
 	matchVersionKubeConfigFlags := cmdutil.NewMatchVersionFlags(kubeConfigFlags)
 	matchVersionKubeConfigFlags.AddFlags(flags)
@@ -176,7 +233,12 @@ A Command Line Interface for KubeBlocks`,
 		plugin.NewPluginCmd(ioStreams),
 		report.NewReportCmd(f, ioStreams),
 		backuprepo.NewBackupRepoCmd(f, ioStreams),
+		storageprovider.NewStorageProviderCmd(f, ioStreams),
 		dataprotection.NewDataProtectionCmd(f, ioStreams),
+		bench.NewBenchCmd(f, ioStreams),
+		completion.NewCompletionCmd(ioStreams.Out),
+		config.NewConfigCmd(ioStreams),
+		config.NewProfileCmd(ioStreams),
 	)
 
 	filters := []string{"options"}
@@ -198,6 +260,17 @@ A Command Line Interface for KubeBlocks`,
 	return cmd
 }
 
+// parseProfileFlag returns the value of --profile in the args of a command which disables the flag parsing.
+func parseProfileFlag(args []string) (string, error) {
+	var profile string
+	t := flags.NewTmpFlagSet()
+	t.StringVar(&profile, profileFlag, "", "")
+	if err := t.Parse(args); err != nil {
+		return "", err
+	}
+	return profile, nil
+}
+
 func initLog() {
 	ctrl.SetLogger(klog.NewKlogr())
 }
@@ -224,6 +297,7 @@ func initConfig() {
 }
 
 func registerCompletionFuncForGlobalFlags(cmd *cobra.Command, f cmdutil.Factory) {
+	cmdutil.CheckErr(cmd.RegisterFlagCompletionFunc(profileFlag, config.CompleteProfileNames))
 	cmdutil.CheckErr(cmd.RegisterFlagCompletionFunc(
 		"namespace",
 		func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
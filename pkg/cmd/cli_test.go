@@ -20,7 +20,19 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package cmd
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/apecloud/kbcli/pkg/types"
+	"github.com/apecloud/kbcli/pkg/util/flags"
 )
 
 func TestNewCli(t *testing.T) {
@@ -31,3 +43,125 @@ func TestNewCli(t *testing.T) {
 		cmd.Run(cmd, []string{"help"})
 	}
 }
+
+func TestProfileAppliedWithSubCommandHook(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv(types.CliHomeEnv, home)
+	if err := os.WriteFile(filepath.Join(home, "profiles.yaml"), []byte("profiles:\n  prod:\n    kubeconfig: /tmp/prod-kubeconfig\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var hookCalled bool
+	var kubeconfig string
+	root := NewCliCmd()
+	sub := &cobra.Command{
+		Use: "sub",
+		PersistentPreRun: func(_ *cobra.Command, _ []string) {
+			hookCalled = true
+		},
+		Run: func(cmd *cobra.Command, _ []string) {
+			kubeconfig, _ = cmd.Flags().GetString("kubeconfig")
+		},
+	}
+	root.AddCommand(sub)
+	root.SetArgs([]string{"sub", "--profile", "prod"})
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !hookCalled {
+		t.Fatal("the persistent hook of the subcommand should be called")
+	}
+	if kubeconfig != "/tmp/prod-kubeconfig" {
+		t.Fatalf("the profile should be applied, kubeconfig is %q", kubeconfig)
+	}
+}
+
+func TestProfileAppliedWithDisableFlagParsing(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv(types.CliHomeEnv, home)
+	if err := os.WriteFile(filepath.Join(home, "profiles.yaml"), []byte("profiles:\n  prod:\n    kubeconfig: /tmp/prod-kubeconfig\n    namespace: prod\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var kubeconfig, namespace string
+	root := NewCliCmd()
+	sub := &cobra.Command{
+		Use:                "sub",
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := flags.ParseInheritedFlags(cmd, args); err != nil {
+				return err
+			}
+			kubeconfig, _ = cmd.Flags().GetString("kubeconfig")
+			namespace, _ = cmd.Flags().GetString("namespace")
+			return nil
+		},
+	}
+	root.AddCommand(sub)
+	root.SetArgs([]string{"sub", "--custom", "value", "--profile", "prod", "-n", "default"})
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if kubeconfig != "/tmp/prod-kubeconfig" {
+		t.Fatalf("the profile should be applied, kubeconfig is %q", kubeconfig)
+	}
+	if namespace != "default" {
+		t.Fatalf("the explicit namespace should take precedence over the profile, namespace is %q", namespace)
+	}
+}
+
+func TestSetTimeoutContext(t *testing.T) {
+	root := &cobra.Command{Use: "root"}
+	root.PersistentFlags().Duration(timeoutFlag, 0, "")
+	sub := &cobra.Command{Use: "sub"}
+	local := &cobra.Command{Use: "local"}
+	local.Flags().Duration(timeoutFlag, 0, "")
+	root.AddCommand(sub, local)
+	for _, c := range []*cobra.Command{sub, local} {
+		c.SetContext(context.Background())
+	}
+
+	if deadline := setTimeoutContext(sub, 0); !deadline.IsZero() {
+		t.Fatal("zero timeout should not set a deadline")
+	}
+	if _, ok := sub.Context().Deadline(); ok {
+		t.Fatal("zero timeout should not set a deadline")
+	}
+	deadline := setTimeoutContext(sub, time.Minute)
+	if d, ok := sub.Context().Deadline(); !ok || !d.Equal(deadline) {
+		t.Fatal("the context of command should have the deadline")
+	}
+	setTimeoutContext(local, time.Minute)
+	if _, ok := local.Context().Deadline(); ok {
+		t.Fatal("the command with its own --timeout flag should not be affected")
+	}
+}
+
+func TestDeadlineRoundTripper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			<-r.Context().Done()
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var deadline time.Time
+	client := &http.Client{Transport: &deadlineRoundTripper{
+		deadline: func() time.Time { return deadline },
+		rt:       http.DefaultTransport,
+	}}
+
+	// no deadline
+	resp, err := client.Get(server.URL + "/fast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	// the request is canceled when the deadline is exceeded
+	deadline = time.Now().Add(100 * time.Millisecond)
+	if _, err = client.Get(server.URL + "/slow"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("the request should exceed the deadline, got %v", err)
+	}
+}
@@ -0,0 +1,421 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/retry"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+
+	"github.com/apecloud/kbcli/pkg/action"
+	"github.com/apecloud/kbcli/pkg/printer"
+	"github.com/apecloud/kbcli/pkg/types"
+	"github.com/apecloud/kbcli/pkg/util"
+	"github.com/apecloud/kbcli/pkg/util/prompt"
+)
+
+// backupScheduleInfo is a row of the backup schedule list, a backup schedule
+// contains one schedule for each backup method.
+type backupScheduleInfo struct {
+	Namespace string
+	Name      string
+	Cluster   string
+	Method    string
+	Cron      string
+	NextRun   string
+	Status    string
+}
+
+// buildBackupScheduleInfo builds the information of each schedule in the backup schedule,
+// the next run is calculated from the cron expression which is in UTC.
+func buildBackupScheduleInfo(schedule *dpv1alpha1.BackupSchedule, now time.Time) []backupScheduleInfo {
+	var infos []backupScheduleInfo
+	for _, s := range schedule.Spec.Schedules {
+		info := backupScheduleInfo{
+			Namespace: schedule.Namespace,
+			Name:      schedule.Name,
+			Cluster:   schedule.Labels[constant.AppInstanceLabelKey],
+			Method:    s.BackupMethod,
+			Cron:      s.CronExpression,
+			NextRun:   "-",
+			Status:    string(schedule.Status.Phase),
+		}
+		if status, ok := schedule.Status.Schedules[s.BackupMethod]; ok && status.Phase != "" {
+			info.Status = string(status.Phase)
+		}
+		if s.Enabled == nil || !*s.Enabled {
+			info.Status = "Disabled"
+		} else if sched, err := cron.ParseStandard(s.CronExpression); err == nil {
+			info.NextRun = util.TimeTimeFormat(sched.Next(now.UTC()))
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// PrintBackupScheduleList prints the backup schedules with the next run time of each schedule.
+func PrintBackupScheduleList(o action.ListOptions) error {
+	// if format is JSON, YAML or template, use default printer to output the result.
+	if o.Format == printer.JSON || o.Format == printer.YAML || o.Format == printer.Template {
+		_, err := o.Run()
+		return err
+	}
+	dynamic, err := o.Factory.DynamicClient()
+	if err != nil {
+		return err
+	}
+	if o.AllNamespaces {
+		o.Namespace = ""
+	}
+	objs, err := dynamic.Resource(types.BackupScheduleGVR()).Namespace(o.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: o.LabelSelector,
+		FieldSelector: o.FieldSelector,
+	})
+	if err != nil {
+		return err
+	}
+	names := make(map[string]bool)
+	for _, name := range o.Names {
+		names[name] = true
+	}
+
+	var schedules []*dpv1alpha1.BackupSchedule
+	for _, obj := range objs.Items {
+		if len(names) > 0 && !names[obj.GetName()] {
+			continue
+		}
+		schedule := &dpv1alpha1.BackupSchedule{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, schedule); err != nil {
+			return err
+		}
+		schedules = append(schedules, schedule)
+	}
+	if len(schedules) == 0 {
+		o.PrintNotFoundResources()
+		return nil
+	}
+	printBackupSchedules(o.Out, schedules)
+	return nil
+}
+
+func printBackupSchedules(out io.Writer, schedules []*dpv1alpha1.BackupSchedule) {
+	tbl := printer.NewTablePrinter(out)
+	tbl.SetHeader("NAMESPACE", "NAME", "CLUSTER", "METHOD", "CRON", "NEXT-RUN", "STATUS")
+	now := time.Now()
+	for _, schedule := range schedules {
+		for _, info := range buildBackupScheduleInfo(schedule, now) {
+			tbl.AddRow(info.Namespace, info.Name, info.Cluster, info.Method, info.Cron, info.NextRun, info.Status)
+		}
+	}
+	tbl.Print()
+}
+
+type CreateBackupScheduleOptions struct {
+	Name             string
+	Namespace        string
+	ClusterName      string
+	BackupPolicyName string
+	BackupMethod     string
+	CronExpression   string
+	RetentionPeriod  string
+
+	Dynamic dynamic.Interface
+	Factory cmdutil.Factory
+	genericiooptions.IOStreams
+}
+
+func (o *CreateBackupScheduleOptions) Complete() error {
+	var err error
+	if o.Namespace, _, err = o.Factory.ToRawKubeConfigLoader().Namespace(); err != nil {
+		return err
+	}
+	if o.Dynamic, err = o.Factory.DynamicClient(); err != nil {
+		return err
+	}
+	if o.ClusterName == "" {
+		return fmt.Errorf("missing cluster name, please specify it by --cluster")
+	}
+	if o.BackupPolicyName == "" {
+		if o.BackupPolicyName, err = GetDefaultBackupPolicy(o.Dynamic, o.Namespace, o.ClusterName); err != nil {
+			return err
+		}
+	}
+	if o.Name == "" && o.BackupMethod != "" {
+		o.Name = fmt.Sprintf("%s-%s-schedule", o.ClusterName, o.BackupMethod)
+	}
+	return nil
+}
+
+func (o *CreateBackupScheduleOptions) Validate() error {
+	if o.BackupMethod == "" {
+		return fmt.Errorf("missing backup method, please specify it by --backup-method")
+	}
+	if o.CronExpression == "" {
+		return fmt.Errorf("missing cron expression, please specify it by --cron-expression")
+	}
+	if _, err := cron.ParseStandard(o.CronExpression); err != nil {
+		return fmt.Errorf("invalid cron expression: %s, please see https://en.wikipedia.org/wiki/Cron", o.CronExpression)
+	}
+	if o.RetentionPeriod != "" {
+		if _, err := dpv1alpha1.RetentionPeriod(o.RetentionPeriod).ToDuration(); err != nil {
+			return fmt.Errorf("invalid retention period %s: %v", o.RetentionPeriod, err)
+		}
+	}
+	backupPolicy := &dpv1alpha1.BackupPolicy{}
+	if err := util.GetK8SClientObject(o.Dynamic, backupPolicy, types.BackupPolicyGVR(), o.Namespace, o.BackupPolicyName); err != nil {
+		return err
+	}
+	var methods []string
+	for _, m := range backupPolicy.Spec.BackupMethods {
+		if m.Name == o.BackupMethod {
+			return nil
+		}
+		methods = append(methods, m.Name)
+	}
+	return fmt.Errorf("backup method %s is not found in backup policy %s, supported methods: %v", o.BackupMethod, o.BackupPolicyName, methods)
+}
+
+func (o *CreateBackupScheduleOptions) Run() error {
+	enabled := true
+	schedule := &dpv1alpha1.BackupSchedule{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: fmt.Sprintf("%s/%s", types.DPAPIGroup, types.DPAPIVersion),
+			Kind:       types.KindBackupSchedule,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      o.Name,
+			Namespace: o.Namespace,
+			Labels: map[string]string{
+				constant.AppInstanceLabelKey: o.ClusterName,
+			},
+		},
+		Spec: dpv1alpha1.BackupScheduleSpec{
+			BackupPolicyName: o.BackupPolicyName,
+			Schedules: []dpv1alpha1.SchedulePolicy{
+				{
+					Enabled:         &enabled,
+					BackupMethod:    o.BackupMethod,
+					CronExpression:  o.CronExpression,
+					RetentionPeriod: dpv1alpha1.RetentionPeriod(o.RetentionPeriod),
+				},
+			},
+		},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(schedule)
+	if err != nil {
+		return err
+	}
+	if _, err = o.Dynamic.Resource(types.BackupScheduleGVR()).Namespace(o.Namespace).Create(context.TODO(),
+		&unstructured.Unstructured{Object: obj}, metav1.CreateOptions{}); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "Backup schedule %s created\n", o.Name)
+	return nil
+}
+
+// backupScheduleUpdateBackoff retries the update of a backup schedule up to 3 times on conflicts
+var backupScheduleUpdateBackoff = wait.Backoff{
+	Steps:    3,
+	Duration: 100 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+}
+
+// ToggleBackupScheduleOptions enables or disables the schedules of a backup schedule.
+type ToggleBackupScheduleOptions struct {
+	Name      string
+	Namespace string
+	// BackupMethod is the method of the schedule to toggle, all schedules are toggled if empty
+	BackupMethod string
+	Enabled      bool
+
+	Dynamic dynamic.Interface
+	Factory cmdutil.Factory
+	genericiooptions.IOStreams
+}
+
+func (o *ToggleBackupScheduleOptions) Complete() error {
+	var err error
+	if o.Namespace, _, err = o.Factory.ToRawKubeConfigLoader().Namespace(); err != nil {
+		return err
+	}
+	o.Dynamic, err = o.Factory.DynamicClient()
+	return err
+}
+
+func (o *ToggleBackupScheduleOptions) Validate() error {
+	if o.Name == "" {
+		return fmt.Errorf("missing backup schedule name")
+	}
+	schedule := &dpv1alpha1.BackupSchedule{}
+	if err := util.GetK8SClientObject(o.Dynamic, schedule, types.BackupScheduleGVR(), o.Namespace, o.Name); err != nil {
+		return err
+	}
+	if o.BackupMethod == "" {
+		return nil
+	}
+	var methods []string
+	for _, s := range schedule.Spec.Schedules {
+		if s.BackupMethod == o.BackupMethod {
+			return nil
+		}
+		methods = append(methods, s.BackupMethod)
+	}
+	return fmt.Errorf("backup method %s is not found in backup schedule %s, scheduled methods: %v", o.BackupMethod, o.Name, methods)
+}
+
+func (o *ToggleBackupScheduleOptions) Run() error {
+	client := o.Dynamic.Resource(types.BackupScheduleGVR()).Namespace(o.Namespace)
+	err := retry.RetryOnConflict(backupScheduleUpdateBackoff, func() error {
+		obj, err := client.Get(context.TODO(), o.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		schedule := &dpv1alpha1.BackupSchedule{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, schedule); err != nil {
+			return err
+		}
+		for i := range schedule.Spec.Schedules {
+			if o.BackupMethod == "" || schedule.Spec.Schedules[i].BackupMethod == o.BackupMethod {
+				enabled := o.Enabled
+				schedule.Spec.Schedules[i].Enabled = &enabled
+			}
+		}
+		if obj.Object, err = runtime.DefaultUnstructuredConverter.ToUnstructured(schedule); err != nil {
+			return err
+		}
+		_, err = client.Update(context.TODO(), obj, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// fetch the updated backup schedule to confirm the change
+	schedule := &dpv1alpha1.BackupSchedule{}
+	if err = util.GetK8SClientObject(o.Dynamic, schedule, types.BackupScheduleGVR(), o.Namespace, o.Name); err != nil {
+		return err
+	}
+	printBackupSchedules(o.Out, []*dpv1alpha1.BackupSchedule{schedule})
+	return nil
+}
+
+// DeleteBackupScheduleOptions deletes the backup schedules, and the backups created by them if Cascade is true.
+type DeleteBackupScheduleOptions struct {
+	*action.DeleteOptions
+	Cascade bool
+
+	// scheduledBackups records the confirmed backups to delete of each backup schedule
+	scheduledBackups map[string][]string
+}
+
+func NewDeleteBackupScheduleOptions(f cmdutil.Factory, streams genericiooptions.IOStreams) *DeleteBackupScheduleOptions {
+	o := &DeleteBackupScheduleOptions{
+		DeleteOptions:    action.NewDeleteOptions(f, streams, types.BackupScheduleGVR()),
+		scheduledBackups: map[string][]string{},
+	}
+	o.PreDeleteHook = o.preDeleteBackupSchedule
+	o.PostDeleteHook = o.postDeleteBackupSchedule
+	return o
+}
+
+// preDeleteBackupSchedule finds the backups created by the backup schedule and confirms to delete them
+// separately from the backup schedule itself. If the deletion of the backups is declined, only the
+// backup schedule is deleted.
+func (o *DeleteBackupScheduleOptions) preDeleteBackupSchedule(_ *action.DeleteOptions, obj runtime.Object) error {
+	if !o.Cascade {
+		return nil
+	}
+	schedule := obj.(*unstructured.Unstructured)
+	dynamic, err := o.Factory.DynamicClient()
+	if err != nil {
+		return err
+	}
+	backups, err := dynamic.Resource(types.BackupGVR()).Namespace(schedule.GetNamespace()).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", dptypes.BackupScheduleLabelKey, schedule.GetName()),
+	})
+	if err != nil {
+		return err
+	}
+	var names []string
+	for _, backup := range backups.Items {
+		names = append(names, backup.GetName())
+	}
+	fmt.Fprintf(o.Out, "Found %d backups created by backup schedule %s\n", len(names), schedule.GetName())
+	if len(names) == 0 {
+		return nil
+	}
+	if !o.AutoApprove {
+		if err = prompt.Confirm(nil, o.In, fmt.Sprintf("backups to be deleted:[%s]", printer.BoldRed(strings.Join(names, " "))),
+			"Please type 'yes' to delete the backups as well:"); err != nil {
+			// declining to delete the backups should not abort deleting the backup schedule
+			fmt.Fprintf(o.Out, "Skip deleting the backups of backup schedule %s\n", schedule.GetName())
+			return nil
+		}
+	}
+	o.scheduledBackups[schedule.GetNamespace()+"/"+schedule.GetName()] = names
+	return nil
+}
+
+// postDeleteBackupSchedule deletes the confirmed backups after the backup schedule is deleted,
+// so that no new backup will be created by the schedule.
+func (o *DeleteBackupScheduleOptions) postDeleteBackupSchedule(_ *action.DeleteOptions, obj runtime.Object) error {
+	schedule, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+	names := o.scheduledBackups[schedule.GetNamespace()+"/"+schedule.GetName()]
+	if len(names) == 0 {
+		return nil
+	}
+	dynamic, err := o.Factory.DynamicClient()
+	if err != nil {
+		return err
+	}
+	deleted := 0
+	for _, name := range names {
+		err = dynamic.Resource(types.BackupGVR()).Namespace(schedule.GetNamespace()).Delete(context.TODO(), name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete backup %s: %v", name, err)
+		}
+		if err == nil {
+			deleted++
+		}
+	}
+	fmt.Fprintf(o.Out, "%d backups of backup schedule %s deleted\n", deleted, schedule.GetName())
+	return nil
+}
@@ -44,6 +44,9 @@ func NewClusterCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra
 				NewListInstancesCmd(f, streams),
 				NewListComponentsCmd(f, streams),
 				NewListEventsCmd(f, streams),
+				NewEventsCmd(f, streams),
+				NewStatusCmd(f, streams),
+				NewTopologyCmd(f, streams),
 				NewLabelCmd(f, streams),
 				NewDeleteCmd(f, streams),
 				newRegisterCmd(f, streams),
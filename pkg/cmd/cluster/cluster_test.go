@@ -20,20 +20,30 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package cluster
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 	"k8s.io/cli-runtime/pkg/resource"
 	"k8s.io/client-go/kubernetes/scheme"
 	clientfake "k8s.io/client-go/rest/fake"
+	clienttesting "k8s.io/client-go/testing"
 	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
 
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
 	testapps "github.com/apecloud/kubeblocks/pkg/testutil/apps"
 
 	"github.com/apecloud/kbcli/pkg/action"
@@ -379,6 +389,7 @@ var _ = Describe("Cluster", func() {
 			}
 
 			tf.Client = tf.UnstructuredClient
+			tf.FakeDynamicClient = testing.FakeDynamicClient()
 			o = &action.DeleteOptions{
 				Factory:     tf,
 				IOStreams:   streams,
@@ -396,6 +407,71 @@ var _ = Describe("Cluster", func() {
 			Expect(deleteCluster(o, []string{clusterName})).Should(HaveOccurred())
 		})
 
+		It("warn backups of the cluster to be deleted", func() {
+			backup := testing.FakeBackup("test-backup")
+			backup.Namespace = namespace
+			backup.Labels = map[string]string{constant.AppInstanceLabelKey: clusterName}
+			tf.FakeDynamicClient = testing.FakeDynamicClient(backup)
+			var errOut *bytes.Buffer
+			o.IOStreams, _, _, errOut = genericiooptions.NewTestIOStreams()
+			Expect(deleteCluster(o, []string{clusterName})).Should(Succeed())
+			Expect(errOut.String()).Should(ContainSubstring("test-backup"))
+
+			By("failing to list the backups does not block deleting the cluster")
+			errOut.Reset()
+			fakeDynamic := testing.FakeDynamicClient()
+			fakeDynamic.PrependReactor("list", "backups", func(clienttesting.Action) (bool, runtime.Object, error) {
+				return true, nil, apierrors.NewForbidden(types.BackupGVR().GroupResource(), "", fmt.Errorf("no permission"))
+			})
+			tf.FakeDynamicClient = fakeDynamic
+			Expect(deleteCluster(o, []string{clusterName})).Should(Succeed())
+			Expect(errOut.String()).Should(ContainSubstring("failed to check the backups of cluster"))
+		})
+
+		It("update termination policy before deleting", func() {
+			Expect(validateTerminationPolicy("Delete")).Should(Succeed())
+			Expect(validateTerminationPolicy("Unknown")).Should(HaveOccurred())
+
+			c := testing.FakeCluster(clusterName, namespace)
+			c.Spec.TerminationPolicy = appsv1alpha1.DoNotTerminate
+			tf.FakeDynamicClient = testing.FakeDynamicClient(c)
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(c)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(clusterPreDeleteHook(o, &unstructured.Unstructured{Object: obj}, "")).Should(HaveOccurred())
+			Expect(clusterPreDeleteHook(o, &unstructured.Unstructured{Object: obj}, "Delete")).Should(Succeed())
+
+			u, err := tf.FakeDynamicClient.Resource(types.ClusterGVR()).Namespace(namespace).Get(context.TODO(), clusterName, metav1.GetOptions{})
+			Expect(err).ShouldNot(HaveOccurred())
+			policy, _, _ := unstructured.NestedString(u.Object, "spec", "terminationPolicy")
+			Expect(policy).Should(Equal("Delete"))
+		})
+
+		It("wait for the cluster to be removed", func() {
+			c := testing.FakeCluster(clusterName, namespace)
+			By("the cluster is already removed")
+			Expect(waitClustersDeleted(o, []*appsv1alpha1.Cluster{c}, time.Second)).Should(Succeed())
+
+			By("the cluster is removed after a while")
+			fakeWatcher := watch.NewFake()
+			fakeDynamic := testing.FakeDynamicClient(c)
+			fakeDynamic.PrependWatchReactor("clusters", clienttesting.DefaultWatchReactor(fakeWatcher, nil))
+			tf.FakeDynamicClient = fakeDynamic
+			errCh := make(chan error)
+			go func() {
+				errCh <- waitClustersDeleted(o, []*appsv1alpha1.Cluster{c}, time.Minute)
+			}()
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(c)
+			Expect(err).ShouldNot(HaveOccurred())
+			fakeWatcher.Delete(&unstructured.Unstructured{Object: obj})
+			Eventually(errCh).Should(Receive(BeNil()))
+			Expect(o.Out.(*bytes.Buffer).String()).Should(ContainSubstring(fmt.Sprintf("Cluster %s is removed", clusterName)))
+
+			By("the cluster is not removed before timeout")
+			fakeDynamic = testing.FakeDynamicClient(c)
+			fakeDynamic.PrependWatchReactor("clusters", clienttesting.DefaultWatchReactor(watch.NewFake(), nil))
+			tf.FakeDynamicClient = fakeDynamic
+			Expect(waitClustersDeleted(o, []*appsv1alpha1.Cluster{c}, 100*time.Millisecond)).Should(MatchError(ContainSubstring("timed out waiting for cluster")))
+		})
 	})
 	It("delete", func() {
 		cmd := NewDeleteCmd(tf, streams)
@@ -74,7 +74,10 @@ var connectExample = templates.Examples(`
 		kbcli cluster connect mycluster --show-example
 
 		# show cli connection examples with real password
-		kbcli cluster connect mycluster --show-example --client=cli --show-password`)
+		kbcli cluster connect mycluster --show-example --client=cli --show-password
+
+		# execute a SQL statement non-interactively and exit with the client's exit code
+		kbcli cluster connect mycluster --exec "SELECT version();"`)
 
 const passwordMask = "******"
 
@@ -109,6 +112,8 @@ type ConnectOptions struct {
 	characterType string
 	userName      string
 	userPasswd    string
+	database      string
+	execCmd       string
 
 	*action.ExecOptions
 }
@@ -139,6 +144,8 @@ func NewConnectCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra
 	cmd.Flags().StringVar(&o.clientType, "client", "", "Which client connection example should be output, only valid if --show-example is true.")
 
 	cmd.Flags().StringVar(&o.userName, "as-user", "", "Connect to cluster as user")
+	cmd.Flags().StringVar(&o.database, "database", "", "The database to connect, only valid if --show-example is true.")
+	cmd.Flags().StringVarP(&o.execCmd, "exec", "e", "", "The command or SQL statement to execute non-interactively, the exit code of the client is returned.")
 
 	util.CheckErr(cmd.RegisterFlagCompletionFunc("client", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		var types []string
@@ -192,6 +199,15 @@ func (o *ConnectOptions) Validate(args []string) error {
 		return fmt.Errorf("either cluster name or instance name should be specified")
 	}
 
+	if len(o.execCmd) > 0 && o.showExample {
+		return fmt.Errorf("--exec and --show-example are exclusive")
+	}
+
+	// the connect command of the engine always connects to its default database
+	if len(o.database) > 0 && !o.showExample {
+		return fmt.Errorf("--database is only valid with --show-example")
+	}
+
 	// set custer name
 	if len(args) > 0 {
 		o.clusterName = args[0]
@@ -291,6 +307,13 @@ func (o *ConnectOptions) Connect() error {
 
 	o.ExecOptions.ContainerName = o.engine.Container()
 	o.ExecOptions.Command = o.engine.ConnectCommand(authInfo)
+	// feed the command to the client through stdin without a tty, so that the client
+	// runs in batch mode and exits with the status of the command
+	if len(o.execCmd) > 0 {
+		o.ExecOptions.TTY = false
+		o.ExecOptions.Stdin = true
+		o.ExecOptions.In = strings.NewReader(o.execCmd + "\n")
+	}
 	if klog.V(1).Enabled() {
 		fmt.Fprintf(o.Out, "connect with cmd: %s", o.ExecOptions.Command)
 	}
@@ -393,6 +416,7 @@ func (o *ConnectOptions) getConnectionInfo() (*engines.ConnectionInfo, error) {
 
 	info.ClusterName = o.clusterName
 	info.ComponentName = o.componentName
+	info.Database = o.database
 	info.HeadlessEndpoint = getOneHeadlessEndpoint(objs.ClusterDef, objs.Secrets)
 	// get username and password
 	if o.componentDefV2 != nil {
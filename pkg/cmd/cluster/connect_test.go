@@ -20,7 +20,9 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package cluster
 
 import (
+	"io"
 	"net/http"
+	"net/url"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -32,7 +34,9 @@ import (
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 	"k8s.io/cli-runtime/pkg/resource"
 	"k8s.io/client-go/kubernetes/scheme"
+	restclient "k8s.io/client-go/rest"
 	clientfake "k8s.io/client-go/rest/fake"
+	"k8s.io/client-go/tools/remotecommand"
 	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
 
 	"github.com/apecloud/kbcli/pkg/action"
@@ -110,6 +114,12 @@ var _ = Describe("connection", func() {
 		// unset component name
 		o.componentName = ""
 		Expect(o.Validate([]string{clusterName})).Should(Succeed())
+
+		By("--database requires --show-example")
+		o.database = "test-db"
+		Expect(o.Validate([]string{clusterName})).Should(MatchError(ContainSubstring("--database is only valid with --show-example")))
+		o.showExample = true
+		Expect(o.Validate([]string{clusterName})).Should(Succeed())
 	})
 
 	It("complete by cluster name", func() {
@@ -136,6 +146,24 @@ var _ = Describe("connection", func() {
 		Expect(o.runShowExample()).Should(Succeed())
 	})
 
+	It("exec command", func() {
+		o := &ConnectOptions{ExecOptions: action.NewExecOptions(tf, streams), execCmd: "SELECT 1;"}
+
+		By("--exec and --show-example are exclusive")
+		o.showExample = true
+		Expect(o.Validate([]string{clusterName})).Should(MatchError(ContainSubstring("exclusive")))
+		o.showExample = false
+
+		Expect(o.Validate([]string{clusterName})).Should(Succeed())
+		Expect(o.Complete()).Should(Succeed())
+		executor := &fakeRemoteExecutor{}
+		o.Executor = executor
+		o.Config = &restclient.Config{APIPath: "/api", ContentConfig: restclient.ContentConfig{NegotiatedSerializer: scheme.Codecs.WithoutConversion(), GroupVersion: &schema.GroupVersion{Version: "v1"}}}
+		Expect(o.Connect()).Should(Succeed())
+		Expect(executor.tty).Should(BeFalse())
+		Expect(executor.stdin).Should(Equal("SELECT 1;\n"))
+	})
+
 	Context("getConnectionInfo", func() {
 		const (
 			user     = "test-user"
@@ -168,6 +196,15 @@ var _ = Describe("connection", func() {
 			Expect(err).Should(Succeed())
 			Expect(info.Password).Should(Equal(password))
 		})
+
+		It("--database", func() {
+			o := &ConnectOptions{ExecOptions: action.NewExecOptions(tf, streams), database: "test-db", showExample: true}
+			Expect(o.Validate([]string{clusterName})).Should(Succeed())
+			Expect(o.Complete()).Should(Succeed())
+			info, err := o.getConnectionInfo()
+			Expect(err).Should(Succeed())
+			Expect(info.Database).Should(Equal("test-db"))
+		})
 	})
 })
 
@@ -196,6 +233,23 @@ func mockPod() *corev1.Pod {
 	}
 }
 
+type fakeRemoteExecutor struct {
+	tty   bool
+	stdin string
+}
+
+func (f *fakeRemoteExecutor) Execute(method string, url *url.URL, config *restclient.Config, stdin io.Reader, stdout, stderr io.Writer, tty bool, terminalSizeQueue remotecommand.TerminalSizeQueue) error {
+	f.tty = tty
+	if stdin != nil {
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return err
+		}
+		f.stdin = string(data)
+	}
+	return nil
+}
+
 func findPod(pods *corev1.PodList, name string) *corev1.Pod {
 	for i, pod := range pods.Items {
 		if pod.Name == name {
@@ -28,8 +28,10 @@ import (
 	"net/http"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ghodss/yaml"
 	"github.com/robfig/cron/v3"
@@ -41,10 +43,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
 	rbacv1ac "k8s.io/client-go/applyconfigurations/rbac/v1"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	utilcomp "k8s.io/kubectl/pkg/util/completion"
@@ -66,6 +70,7 @@ import (
 	"github.com/apecloud/kbcli/pkg/printer"
 	"github.com/apecloud/kbcli/pkg/types"
 	"github.com/apecloud/kbcli/pkg/util"
+	"github.com/apecloud/kbcli/pkg/util/prompt"
 )
 
 var clusterCreateExample = templates.Examples(`
@@ -100,6 +105,9 @@ var clusterCreateExample = templates.Examples(`
 	# Create a cluster and set cpu to 1 core, memory to 1Gi, storage size to 20Gi and replicas to 3
 	kbcli cluster create mycluster --cluster-definition apecloud-mysql --set cpu=1,memory=1Gi,storage=20Gi,replicas=3
 
+	# Create a cluster and set replicas to 3 and storage size to 20Gi of the default component with the shorthand flags
+	kbcli cluster create mycluster --cluster-definition apecloud-mysql --replica-count 3 --storage-size 20Gi
+
 	# Create a cluster and set storageClass to csi-hostpath-sc, if storageClass is not specified,
 	# the default storage class will be used
 	kbcli cluster create mycluster --cluster-definition apecloud-mysql --set storageClass=csi-hostpath-sc
@@ -151,6 +159,12 @@ var clusterCreateExample = templates.Examples(`
 
 	# Create a cluster with using a service reference to another KubeBlocks cluster
 	kbcli cluster create --cluster-definition pulsar --service-reference name=pulsarZookeeper,cluster=zookeeper,namespace=default
+
+	# Create a cluster from a Cluster object in yaml file as it is, such as a cluster spec stored in git
+	kbcli cluster create mycluster --file mycluster.yaml
+
+	# Create a cluster and wait for it to be running
+	kbcli cluster create mycluster --cluster-definition apecloud-mysql --wait --wait-timeout 10m
 `)
 
 const (
@@ -250,6 +264,7 @@ type CreateOptions struct {
 	// create components exclusively configured in 'set'.
 	CreateOnlySet       bool     `json:"-"`
 	SetFile             string   `json:"-"`
+	File                string   `json:"-"`
 	Values              []string `json:"-"`
 	RBACEnabled         bool     `json:"-"`
 	Storages            []string `json:"-"`
@@ -259,6 +274,10 @@ type CreateOptions struct {
 	CPUOversellRatio    float64  `json:"-"`
 	MemoryOversellRatio float64  `json:"-"`
 
+	// shorthands of --set replicas and storage for the default component
+	ReplicaCount int    `json:"-"`
+	StorageSize  string `json:"-"`
+
 	// backup name to restore in creation
 	Backup              string `json:"backup,omitempty"`
 	RestoreTime         string `json:"restoreTime,omitempty"`
@@ -267,6 +286,10 @@ type CreateOptions struct {
 	// backup config
 	BackupConfig *appsv1alpha1.ClusterBackup `json:"backupConfig,omitempty"`
 
+	// wait for the cluster to be running after it is created
+	Wait        bool          `json:"-"`
+	WaitTimeout time.Duration `json:"-"`
+
 	Cmd *cobra.Command `json:"-"`
 
 	UpdatableFlags
@@ -282,9 +305,14 @@ func NewCreateCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.
 		Run: func(cmd *cobra.Command, args []string) {
 			o.Args = args
 			cmdutil.CheckErr(o.CreateOptions.Complete())
-			cmdutil.CheckErr(o.Complete())
-			cmdutil.CheckErr(o.Validate())
-			cmdutil.CheckErr(o.Run())
+			if o.File != "" {
+				cmdutil.CheckErr(o.CreateFromFile())
+			} else {
+				cmdutil.CheckErr(o.Complete())
+				cmdutil.CheckErr(o.Validate())
+				cmdutil.CheckErr(o.Run())
+			}
+			cmdutil.CheckErr(o.WaitClusterRunning())
 		},
 	}
 
@@ -292,6 +320,9 @@ func NewCreateCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.
 	cmd.Flags().StringVar(&o.ClusterVersionRef, "cluster-version", "", "Specify cluster version, run \"kbcli cv list\" to show all available cluster versions, use the latest version if not specified")
 	cmd.Flags().StringVarP(&o.SetFile, "set-file", "f", "", "Use yaml file, URL, or stdin to set the cluster resource")
 	cmd.Flags().StringArrayVar(&o.Values, "set", []string{}, "Set the cluster resource including cpu, memory, replicas and storage, each set corresponds to a component.(e.g. --set cpu=1,memory=1Gi,replicas=3,storage=20Gi)")
+	cmd.Flags().IntVar(&o.ReplicaCount, "replica-count", 0, "Set the replicas of the default component, it is a shorthand of --set replicas=<count> and the value of --set takes precedence")
+	cmd.Flags().StringVar(&o.StorageSize, "storage-size", "", "Set the size of the first volume of the default component such as 20Gi, it is a shorthand of --set storage=<size> and the value of --set takes precedence")
+	cmd.Flags().StringVar(&o.File, "file", "", "Use yaml file, URL, or stdin of a Cluster object to create the cluster as it is, the cluster is not built from the other flags")
 	cmd.Flags().BoolVar(&o.CreateOnlySet, "create-only-set", false, "Create components exclusively configured in 'set'")
 	cmd.Flags().StringArrayVar(&o.Storages, "pvc", []string{}, "Set the cluster detail persistent volume claim, each '--pvc' corresponds to a component, and will override the simple configurations about storage by --set (e.g. --pvc type=mysql,name=data,mode=ReadWriteOnce,size=20Gi --pvc type=mysql,name=log,mode=ReadWriteOnce,size=1Gi)")
 	cmd.Flags().StringArrayVar(&o.ServiceRef, "service-reference", []string{}, "Set the other KubeBlocks cluster dependencies, each '--service-reference' corresponds to a cluster service. (e.g --service-reference name=pulsarZookeeper,cluster=zookeeper,namespace=default)")
@@ -303,10 +334,13 @@ func NewCreateCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.
 	cmd.Flags().StringVar(&o.Backup, "backup", "", "Set a source backup to restore data")
 	cmd.Flags().StringVar(&o.RestoreTime, "restore-to-time", "", "Set a time for point in time recovery")
 	cmd.Flags().StringVar(&o.VolumeRestorePolicy, "volume-restore-policy", "Parallel", "the volume claim restore policy, supported values: [Serial, Parallel]")
+	cmd.Flags().BoolVar(&o.Wait, "wait", false, "Wait for the cluster to be running")
+	cmd.Flags().DurationVar(&o.WaitTimeout, "wait-timeout", 30*time.Minute, "Time to wait for the cluster to be running, only valid if --wait is true, such as --wait-timeout=10m")
 	cmd.Flags().BoolVar(&o.RBACEnabled, "rbac-enabled", false, "Specify whether rbac resources will be created by kbcli, otherwise KubeBlocks server will try to create rbac resources")
 	cmd.PersistentFlags().BoolVar(&o.EditBeforeCreate, "edit", o.EditBeforeCreate, "Edit the API resource before creating")
 	cmd.PersistentFlags().StringVar(&o.DryRun, "dry-run", "none", `Must be "client", or "server". If with client strategy, only print the object that would be sent, and no data is actually sent. If with server strategy, submit the server-side request, but no data is persistent.`)
 	cmd.PersistentFlags().Lookup("dry-run").NoOptDefVal = "unchanged"
+	o.AddAuditLogFlag(cmd, true)
 
 	// add updatable flags
 	o.UpdatableFlags.addFlags(cmd)
@@ -314,6 +348,14 @@ func NewCreateCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.
 	// add print flags
 	printer.AddOutputFlagForCreate(cmd, &o.Format, true)
 
+	cmd.MarkFlagsMutuallyExclusive("file", "set-file")
+	cmd.MarkFlagsMutuallyExclusive("file", "set")
+	cmd.MarkFlagsMutuallyExclusive("file", "cluster-definition")
+	cmd.MarkFlagsMutuallyExclusive("file", "cluster-version")
+	cmd.MarkFlagsMutuallyExclusive("file", "backup")
+	cmd.MarkFlagsMutuallyExclusive("file", "replica-count")
+	cmd.MarkFlagsMutuallyExclusive("file", "storage-size")
+
 	// register flag completion func
 	registerFlagCompletionFunc(cmd, f)
 
@@ -566,6 +608,188 @@ func (o *CreateOptions) Complete() error {
 	return validateStorageClass(o.Dynamic, o.ComponentSpecs)
 }
 
+// CreateFromFile creates the cluster from the Cluster object in the file specified by --file as it is,
+// the object is validated before it is sent to the server.
+func (o *CreateOptions) CreateFromFile() error {
+	data, err := MultipleSourceComponents(o.File, o.In)
+	if err != nil {
+		return err
+	}
+	obj, err := o.buildClusterFromFile(data)
+	if err != nil {
+		return err
+	}
+	dryRun, err := o.GetDryRunStrategy()
+	if err != nil {
+		return err
+	}
+	if dryRun != action.DryRunClient {
+		createOptions := metav1.CreateOptions{}
+		if dryRun == action.DryRunServer {
+			createOptions.DryRun = []string{metav1.DryRunAll}
+		}
+		if obj, err = o.Dynamic.Resource(types.ClusterGVR()).Namespace(o.Namespace).Create(context.TODO(), obj, createOptions); err != nil {
+			return err
+		}
+		if dryRun == action.DryRunNone {
+			if err = o.WriteAuditLog(o.Factory, types.ClusterGVR(), o.Namespace, o.Name); err != nil {
+				return err
+			}
+			fmt.Fprintf(o.Out, "Cluster %s created\n", o.Name)
+			return nil
+		}
+	}
+	p, err := o.ToPrinter(nil, false)
+	if err != nil {
+		return err
+	}
+	return p.PrintObj(obj, o.Out)
+}
+
+// buildClusterFromFile parses and validates the Cluster object in the file, the kind and API version
+// must match the Cluster CRD, and the unknown fields are not allowed. The cluster name and namespace
+// are taken from the command line if the object does not specify them, and they must match the ones
+// specified explicitly in the command line.
+func (o *CreateOptions) buildClusterFromFile(data []byte) (*unstructured.Unstructured, error) {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{}
+	if err = json.Unmarshal(jsonData, &obj.Object); err != nil {
+		return nil, fmt.Errorf("failed to parse the file specified by --file: %v", err)
+	}
+	expectedGVK := types.ClusterGVR().GroupVersion().WithKind(types.KindCluster)
+	if gvk := obj.GroupVersionKind(); gvk != expectedGVK {
+		return nil, fmt.Errorf("the object in the file specified by --file is %s, only %s is supported", gvk, expectedGVK)
+	}
+	cls := &appsv1alpha1.Cluster{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(obj.Object, cls, true); err != nil {
+		return nil, fmt.Errorf("invalid cluster in the file specified by --file: %v", err)
+	}
+
+	switch {
+	case cls.Name == "" && o.Name == "":
+		if o.Name, err = generateClusterName(o.Dynamic, o.Namespace); err != nil {
+			return nil, err
+		}
+	case cls.Name == "":
+	case o.Name == "":
+		o.Name = cls.Name
+	case cls.Name != o.Name:
+		return nil, fmt.Errorf("the cluster name %s in the file specified by --file does not match the name %s", cls.Name, o.Name)
+	}
+	if cls.Namespace != "" && cls.Namespace != o.Namespace {
+		if o.Cmd != nil && o.Cmd.Flags().Changed("namespace") {
+			return nil, fmt.Errorf("the namespace %s in the file specified by --file does not match the namespace %s", cls.Namespace, o.Namespace)
+		}
+		o.Namespace = cls.Namespace
+	}
+	obj.SetName(o.Name)
+	obj.SetNamespace(o.Namespace)
+
+	if len(cls.Spec.ComponentSpecs) == 0 && len(cls.Spec.ShardingSpecs) == 0 {
+		return nil, fmt.Errorf("the cluster in the file specified by --file has no components")
+	}
+	if cls.Spec.ClusterDefRef != "" {
+		cd, err := cluster.GetClusterDefByName(o.Dynamic, cls.Spec.ClusterDefRef)
+		if err != nil {
+			return nil, err
+		}
+		if err = validateClusterCompSpecs(cls.Spec.ComponentSpecs, cd, "--file"); err != nil {
+			return nil, err
+		}
+	}
+	return obj, nil
+}
+
+// WaitClusterRunning waits for the created cluster to be running if --wait is specified
+func (o *CreateOptions) WaitClusterRunning() error {
+	if !o.Wait {
+		return nil
+	}
+	if dryRun, err := o.GetDryRunStrategy(); err != nil || dryRun != action.DryRunNone {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), o.WaitTimeout)
+	defer cancel()
+	if err := waitClusterRunning(ctx, o.Dynamic, o.Out, o.Namespace, o.Name); err != nil {
+		return err
+	}
+	// hint the users how to connect to the cluster, but do not break the JSON or YAML output
+	if util.IsTerminal(o.Out) && o.Format != printer.JSON && o.Format != printer.YAML {
+		fmt.Fprintf(o.Out, "Connect: kbcli cluster connect %s -n %s\n", o.Name, o.Namespace)
+	}
+	return nil
+}
+
+// waitClusterRunning watches the cluster until its phase is Running and prints the progress,
+// the progress is updated in place on a terminal, otherwise one line is printed per phase change.
+func waitClusterRunning(ctx context.Context, dynamic dynamic.Interface, out io.Writer, namespace, name string) error {
+	client := dynamic.Resource(types.ClusterGVR()).Namespace(namespace)
+	isTerminal := util.IsTerminal(out)
+	var lastPhase appsv1alpha1.ClusterPhase
+	// printPhase prints the phase of the cluster if it changed, and returns true if the cluster is running
+	printPhase := func(obj *unstructured.Unstructured) (bool, error) {
+		cls := &appsv1alpha1.Cluster{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, cls); err != nil {
+			return false, err
+		}
+		phase := cls.Status.Phase
+		if phase == "" {
+			phase = appsv1alpha1.CreatingClusterPhase
+		}
+		if phase == lastPhase {
+			return false, nil
+		}
+		lastPhase = phase
+		running := phase == appsv1alpha1.RunningClusterPhase
+		msg := fmt.Sprintf("Cluster %s is %s", name, phase)
+		if !running {
+			msg += "..."
+		}
+		if isTerminal {
+			// rewrite the current line
+			fmt.Fprintf(out, "\r\033[K%s", msg)
+			if running {
+				fmt.Fprintln(out)
+			}
+		} else {
+			fmt.Fprintln(out, msg)
+		}
+		return running, nil
+	}
+	timeoutErr := func() error {
+		if isTerminal {
+			fmt.Fprintln(out)
+		}
+		return fmt.Errorf("timed out waiting for cluster %s to be running, run \"kbcli cluster describe %s -n %s\" to view the details", name, name, namespace)
+	}
+
+	// the cluster must exist before waiting for it to be running
+	precondition := func(store cache.Store) (bool, error) {
+		exists, err := objectExists(store, namespace, name)
+		if err == nil && !exists {
+			err = errors.NewNotFound(types.ClusterGVR().GroupResource(), name)
+		}
+		return false, err
+	}
+	err := watchObjectUntil(ctx, client, name, precondition, func(event watch.Event) (bool, error) {
+		if event.Type == watch.Deleted {
+			return false, fmt.Errorf("cluster %s is deleted", name)
+		}
+		u, ok := event.Object.(*unstructured.Unstructured)
+		if !ok {
+			return false, nil
+		}
+		return printPhase(u)
+	})
+	if err != nil && ctx.Err() != nil {
+		return timeoutErr()
+	}
+	return err
+}
+
 func (o *CreateOptions) CleanUp() error {
 	if o.Client == nil {
 		return nil
@@ -588,7 +812,16 @@ func (o *CreateOptions) buildComponents(clusterCompSpecs []appsv1alpha1.ClusterC
 		return nil, err
 	}
 
-	compSets, err := buildCompSetsMap(o.Values, cd)
+	shorthandSet, err := o.buildShorthandSet()
+	if err != nil {
+		return nil, err
+	}
+	// the shorthand set is put before the --set values, so the --set values override it
+	values := o.Values
+	if shorthandSet != "" {
+		values = append([]string{shorthandSet}, o.Values...)
+	}
+	compSets, err := buildCompSetsMap(values, cd)
 	if err != nil {
 		return nil, err
 	}
@@ -625,6 +858,9 @@ func (o *CreateOptions) buildComponents(clusterCompSpecs []appsv1alpha1.ClusterC
 	}
 
 	if clusterCompSpecs != nil {
+		if err = validateClusterCompSpecs(clusterCompSpecs, cd, "--set-file"); err != nil {
+			return nil, err
+		}
 		setsCompSpecs, err := buildClusterComp(cd, compSets, o.DisableExporter, o.CreateOnlySet)
 		if err != nil {
 			return nil, err
@@ -704,6 +940,24 @@ var (
 	clusterRoleKind = "ClusterRole"
 )
 
+// buildShorthandSet compiles --replica-count and --storage-size into a set value of the default component.
+func (o *CreateOptions) buildShorthandSet() (string, error) {
+	var sets []string
+	if o.ReplicaCount < 0 {
+		return "", fmt.Errorf("--replica-count must not be negative")
+	}
+	if o.ReplicaCount > 0 {
+		sets = append(sets, fmt.Sprintf("%s=%d", keyReplicas, o.ReplicaCount))
+	}
+	if o.StorageSize != "" {
+		if _, err := resource.ParseQuantity(o.StorageSize); err != nil {
+			return "", fmt.Errorf("invalid --storage-size \"%s\": %v", o.StorageSize, err)
+		}
+		sets = append(sets, fmt.Sprintf("%s=%s", keyStorage, o.StorageSize))
+	}
+	return strings.Join(sets, ","), nil
+}
+
 // buildDependenciesFn creates dependencies function for components, e.g. postgresql depends on
 // a service account, a role and a rolebinding
 func (o *CreateOptions) buildDependenciesFn(cd *appsv1alpha1.ClusterDefinition,
@@ -897,6 +1151,7 @@ func registerFlagCompletionFunc(cmd *cobra.Command, f cmdutil.Factory) {
 			}
 			return clusterVersion, cobra.ShellCompDirectiveNoFileComp
 		}))
+	util.RegisterBackupCompletionFunc(cmd, f)
 
 	var formatsWithDesc = map[string]string{
 		"JSON": "Output result in JSON format",
@@ -1403,7 +1658,7 @@ func buildResourceLabels(clusterName string) map[string]string {
 
 // build the cluster definition
 // if the cluster definition is not specified, pick the cluster definition in the cluster component
-// if neither of them is specified, return an error
+// if neither of them is specified, prompt user to select one when running in a terminal, otherwise return an error
 func (o *CreateOptions) buildClusterDef(cls *appsv1alpha1.Cluster) error {
 	if o.ClusterDefRef != "" {
 		return nil
@@ -1414,9 +1669,31 @@ func (o *CreateOptions) buildClusterDef(cls *appsv1alpha1.Cluster) error {
 		return nil
 	}
 
+	if util.IsTerminal(o.In) {
+		return o.selectClusterDef()
+	}
+
 	return fmt.Errorf("a valid cluster definition is needed, use --cluster-definition to specify one, run \"kbcli clusterdefinition list\" to show all cluster definitions")
 }
 
+// selectClusterDef prompts user to select a cluster definition from the available ones
+func (o *CreateOptions) selectClusterDef() error {
+	objs, err := o.Dynamic.Resource(types.ClusterDefGVR()).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var names []string
+	for _, obj := range objs.Items {
+		names = append(names, obj.GetName())
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("no cluster definition found, run \"kbcli addon list\" to check if the addons are enabled")
+	}
+	sort.Strings(names)
+	o.ClusterDefRef, err = prompt.Select("Select a cluster definition", names, o.In)
+	return err
+}
+
 // build the cluster version
 // if the cluster version is not specified, pick the cluster version in the cluster component
 // if neither of them is specified, pick default cluster version
@@ -1577,6 +1854,38 @@ func parseClusterComponentSpec(compByte []byte) ([]appsv1alpha1.ClusterComponent
 	return compSpecs, nil
 }
 
+// validateClusterCompSpecs validates the component specs parsed from the file specified by fileFlag against
+// the cluster definition, every component must have a name and refer to a component definition in the cluster
+// definition if it does not refer to a ComponentDefinition.
+func validateClusterCompSpecs(compSpecs []appsv1alpha1.ClusterComponentSpec, cd *appsv1alpha1.ClusterDefinition, fileFlag string) error {
+	var validNames []string
+	for _, compDef := range cd.Spec.ComponentDefs {
+		validNames = append(validNames, compDef.Name)
+	}
+	names := map[string]struct{}{}
+	for i, compSpec := range compSpecs {
+		if compSpec.Name == "" {
+			return fmt.Errorf("the name of component %d is required in the file specified by %s", i, fileFlag)
+		}
+		if _, ok := names[compSpec.Name]; ok {
+			return fmt.Errorf("component %s is specified more than once in the file specified by %s", compSpec.Name, fileFlag)
+		}
+		names[compSpec.Name] = struct{}{}
+		// the component refers to a ComponentDefinition instead of a component of the cluster definition
+		if compSpec.ComponentDef != "" {
+			continue
+		}
+		if compSpec.ComponentDefRef == "" {
+			return fmt.Errorf("component %s must specify componentDef or componentDefRef in the file specified by %s", compSpec.Name, fileFlag)
+		}
+		if cd.GetComponentDefByName(compSpec.ComponentDefRef) == nil {
+			return fmt.Errorf("componentDefRef %s of component %s is not found in cluster definition %s, valid component names: [%s]",
+				compSpec.ComponentDefRef, compSpec.Name, cd.Name, strings.Join(validNames, ", "))
+		}
+	}
+	return nil
+}
+
 func setKeys() []string {
 	return []string{
 		string(keyCPU),
@@ -210,6 +210,11 @@ func (o *CreateSubCmdsOptions) Run() error {
 			if err != nil {
 				return err
 			}
+			if dryRun == action.DryRunNone {
+				if err = o.WriteAuditLog(o.Factory, obj.gvr, o.Namespace, resObj.GetName()); err != nil {
+					return err
+				}
+			}
 
 			// only output cluster resource
 			if dryRun != action.DryRunServer && isCluster {
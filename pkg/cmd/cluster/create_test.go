@@ -20,6 +20,8 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package cluster
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -28,17 +30,25 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ghodss/yaml"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	clienttesting "k8s.io/client-go/testing"
 
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
 	"github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
 	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils/boolptr"
 	viper "github.com/apecloud/kubeblocks/pkg/viperx"
 
+	"github.com/apecloud/kbcli/pkg/action"
 	"github.com/apecloud/kbcli/pkg/cluster"
 	"github.com/apecloud/kbcli/pkg/testing"
 	"github.com/apecloud/kbcli/pkg/types"
@@ -362,6 +372,136 @@ var _ = Describe("create", func() {
 		}
 	})
 
+	It("build the set of --replica-count and --storage-size", func() {
+		o := &CreateOptions{}
+		set, err := o.buildShorthandSet()
+		Expect(err).Should(Succeed())
+		Expect(set).Should(BeEmpty())
+
+		o.ReplicaCount = 3
+		o.StorageSize = "20Gi"
+		set, err = o.buildShorthandSet()
+		Expect(err).Should(Succeed())
+		Expect(set).Should(Equal("replicas=3,storage=20Gi"))
+
+		By("the --set values take precedence over the shorthands")
+		res, err := buildCompSetsMap([]string{set, "replicas=5"}, mockCD([]string{"my-comp"}))
+		Expect(err).Should(Succeed())
+		Expect(res["my-comp"]).Should(Equal(map[setKey]string{keyReplicas: "5", keyStorage: "20Gi"}))
+
+		By("invalid shorthands")
+		o.StorageSize = "20G-i"
+		_, err = o.buildShorthandSet()
+		Expect(err).Should(MatchError(ContainSubstring("invalid --storage-size")))
+		o.ReplicaCount = -1
+		_, err = o.buildShorthandSet()
+		Expect(err).Should(MatchError(ContainSubstring("--replica-count must not be negative")))
+	})
+
+	It("validate the component specs from file", func() {
+		cd := testing.FakeClusterDef()
+		compSpecs := []appsv1alpha1.ClusterComponentSpec{
+			{Name: testing.ComponentName, ComponentDefRef: testing.ComponentDefName},
+			{Name: testing.ComponentName + "-1", ComponentDefRef: testing.ExtraComponentDefName},
+		}
+		Expect(validateClusterCompSpecs(compSpecs, cd, "--set-file")).Should(Succeed())
+
+		By("the component name is missing")
+		compSpecs[1].Name = ""
+		Expect(validateClusterCompSpecs(compSpecs, cd, "--set-file")).Should(MatchError(ContainSubstring("the name of component 1 is required")))
+
+		By("the component name is duplicated")
+		compSpecs[1].Name = testing.ComponentName
+		Expect(validateClusterCompSpecs(compSpecs, cd, "--set-file")).Should(MatchError(ContainSubstring("is specified more than once")))
+
+		By("the componentDefRef is not found in the cluster definition")
+		compSpecs[1].Name = testing.ComponentName + "-1"
+		compSpecs[1].ComponentDefRef = "unknown"
+		Expect(validateClusterCompSpecs(compSpecs, cd, "--set-file")).Should(MatchError(ContainSubstring(
+			fmt.Sprintf("valid component names: [%s, %s]", testing.ComponentDefName, testing.ExtraComponentDefName))))
+
+		By("the component refers to a ComponentDefinition")
+		compSpecs[1].ComponentDefRef = ""
+		compSpecs[1].ComponentDef = testing.CompDefName
+		Expect(validateClusterCompSpecs(compSpecs, cd, "--set-file")).Should(Succeed())
+	})
+
+	It("create cluster from file", func() {
+		cls := testing.FakeCluster(testing.ClusterName, testing.Namespace)
+		cls.Status = appsv1alpha1.ClusterStatus{}
+		toYAML := func(obj interface{}) []byte {
+			data, err := yaml.Marshal(obj)
+			Expect(err).Should(Succeed())
+			return data
+		}
+		newOptions := func() *CreateOptions {
+			streams, _, _, _ := genericiooptions.NewTestIOStreams()
+			return &CreateOptions{CreateOptions: action.CreateOptions{
+				Factory:   testing.NewTestFactory(testing.Namespace),
+				IOStreams: streams,
+				Namespace: testing.Namespace,
+				Dynamic:   testing.FakeDynamicClient(testing.FakeClusterDef()),
+			}}
+		}
+
+		By("the cluster is valid")
+		o := newOptions()
+		obj, err := o.buildClusterFromFile(toYAML(cls))
+		Expect(err).Should(Succeed())
+		Expect(obj.GetName()).Should(Equal(testing.ClusterName))
+		Expect(o.Name).Should(Equal(testing.ClusterName))
+
+		By("the name in command line does not match the name in file")
+		o = newOptions()
+		o.Name = "other"
+		_, err = o.buildClusterFromFile(toYAML(cls))
+		Expect(err).Should(MatchError(ContainSubstring("does not match the name other")))
+
+		By("the namespace in file is used if --namespace is not specified")
+		o = newOptions()
+		o.Cmd = &cobra.Command{}
+		o.Cmd.Flags().String("namespace", "", "")
+		other := cls.DeepCopy()
+		other.Namespace = "other"
+		obj, err = o.buildClusterFromFile(toYAML(other))
+		Expect(err).Should(Succeed())
+		Expect(obj.GetNamespace()).Should(Equal("other"))
+
+		By("the namespace in file does not match the specified --namespace")
+		o = newOptions()
+		o.Cmd = &cobra.Command{}
+		o.Cmd.Flags().String("namespace", "", "")
+		Expect(o.Cmd.Flags().Set("namespace", testing.Namespace)).Should(Succeed())
+		_, err = o.buildClusterFromFile(toYAML(other))
+		Expect(err).Should(MatchError(ContainSubstring("does not match the namespace " + testing.Namespace)))
+
+		By("the object is not a cluster")
+		o = newOptions()
+		_, err = o.buildClusterFromFile(toYAML(testing.FakeClusterDef()))
+		Expect(err).Should(MatchError(ContainSubstring("only apps.kubeblocks.io/v1alpha1, Kind=Cluster is supported")))
+
+		By("the cluster has unknown fields")
+		o = newOptions()
+		_, err = o.buildClusterFromFile(append(toYAML(cls), []byte("unknownField: true\n")...))
+		Expect(err).Should(MatchError(ContainSubstring("invalid cluster")))
+
+		By("the component refers to an unknown component of the cluster definition")
+		invalid := cls.DeepCopy()
+		invalid.Spec.ComponentSpecs[0].ComponentDefRef = "unknown"
+		o = newOptions()
+		_, err = o.buildClusterFromFile(toYAML(invalid))
+		Expect(err).Should(MatchError(ContainSubstring("componentDefRef unknown of component")))
+
+		By("create the cluster")
+		o = newOptions()
+		o.File = "-"
+		o.In = bytes.NewReader(toYAML(cls))
+		Expect(o.CreateFromFile()).Should(Succeed())
+		Expect(o.Out.(*bytes.Buffer).String()).Should(ContainSubstring(fmt.Sprintf("Cluster %s created", testing.ClusterName)))
+		_, err = o.Dynamic.Resource(types.ClusterGVR()).Namespace(testing.Namespace).Get(context.TODO(), testing.ClusterName, metav1.GetOptions{})
+		Expect(err).Should(Succeed())
+	})
+
 	It("build tolerations", func() {
 		raw := []string{"engineType=mongo:NoSchedule"}
 		res, err := util.BuildTolerations(raw)
@@ -827,4 +967,55 @@ var _ = Describe("create", func() {
 		}
 
 	})
+
+	It("wait for the cluster to be running", func() {
+		toUnstructured := func(cls *appsv1alpha1.Cluster) *unstructured.Unstructured {
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cls)
+			Expect(err).Should(Succeed())
+			return &unstructured.Unstructured{Object: obj}
+		}
+		cls := testing.FakeCluster(testing.ClusterName, testing.Namespace)
+		cls.Status.Phase = ""
+
+		By("the cluster becomes running")
+		fakeWatcher := watch.NewFake()
+		fakeDynamic := testing.FakeDynamicClient(cls)
+		fakeDynamic.PrependWatchReactor("clusters", clienttesting.DefaultWatchReactor(fakeWatcher, nil))
+		out := &bytes.Buffer{}
+		errCh := make(chan error)
+		go func() {
+			errCh <- waitClusterRunning(context.Background(), fakeDynamic, out, testing.Namespace, testing.ClusterName)
+		}()
+		for _, phase := range []appsv1alpha1.ClusterPhase{appsv1alpha1.CreatingClusterPhase, appsv1alpha1.AbnormalClusterPhase, appsv1alpha1.RunningClusterPhase} {
+			cls.Status.Phase = phase
+			fakeWatcher.Modify(toUnstructured(cls))
+		}
+		Eventually(errCh).Should(Receive(BeNil()))
+		Expect(out.String()).Should(Equal(fmt.Sprintf("Cluster %[1]s is Creating...\nCluster %[1]s is Abnormal...\nCluster %[1]s is Running\n", testing.ClusterName)))
+
+		By("the cluster is not running before timeout")
+		cls.Status.Phase = appsv1alpha1.CreatingClusterPhase
+		fakeDynamic = testing.FakeDynamicClient(cls)
+		fakeDynamic.PrependWatchReactor("clusters", clienttesting.DefaultWatchReactor(watch.NewFake(), nil))
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		Expect(waitClusterRunning(ctx, fakeDynamic, out, testing.Namespace, testing.ClusterName)).Should(MatchError(ContainSubstring("timed out waiting for cluster")))
+
+		By("skip waiting if --wait is not specified")
+		o := &CreateOptions{}
+		Expect(o.WaitClusterRunning()).Should(Succeed())
+
+		By("do not print the connect hint if stdout is not a terminal")
+		cls.Status.Phase = appsv1alpha1.RunningClusterPhase
+		out.Reset()
+		o = &CreateOptions{Wait: true, WaitTimeout: time.Minute}
+		o.Dynamic = testing.FakeDynamicClient(cls)
+		o.Out = out
+		o.Namespace = testing.Namespace
+		o.Name = testing.ClusterName
+		o.DryRun = "none"
+		Expect(o.WaitClusterRunning()).Should(Succeed())
+		Expect(out.String()).Should(ContainSubstring("is Running"))
+		Expect(out.String()).ShouldNot(ContainSubstring("kbcli cluster connect"))
+	})
 })
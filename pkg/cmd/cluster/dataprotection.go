@@ -23,27 +23,41 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"math"
+	"os"
+	"os/signal"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 	"k8s.io/client-go/dynamic"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/util/jsonpath"
+	"k8s.io/klog/v2"
 	"k8s.io/kubectl/pkg/cmd/get"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/cmd/util/editor"
@@ -54,9 +68,11 @@ import (
 	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
 	"github.com/apecloud/kubeblocks/pkg/constant"
 	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils/boolptr"
 
 	"github.com/apecloud/kbcli/pkg/action"
 	"github.com/apecloud/kbcli/pkg/printer"
+	"github.com/apecloud/kbcli/pkg/spinner"
 	"github.com/apecloud/kbcli/pkg/types"
 	"github.com/apecloud/kbcli/pkg/util"
 )
@@ -97,10 +113,28 @@ var (
 
 		# create a backup from a parent backup
 		kbcli cluster backup mycluster --parent-backup parent-backup-name
+
+		# print the backup OpsRequest that would be created without submitting it
+		kbcli cluster backup mycluster --method volume-snapshot --dry-run -o yaml
+
+		# create a backup and wait for it to complete
+		kbcli cluster backup mycluster --method volume-snapshot --wait --wait-timeout 30m
+
+		# create a backup, wait for it to complete and push its duration to the Prometheus push gateway
+		kbcli cluster backup mycluster --wait --metrics-pushgateway http://pushgateway:9091
 	`)
 	listBackupExample = templates.Examples(`
 		# list all backups
 		kbcli cluster list-backups
+
+		# list the backups started in the last 24 hours
+		kbcli cluster list-backups --since 24h
+
+		# list the failed backups in the last 24 hours
+		kbcli cluster list-backups --phase Failed --since 24h
+
+		# list the first 100 backups, and use the printed --continue token to list the next page
+		kbcli cluster list-backups --limit 100
 	`)
 	deleteBackupExample = templates.Examples(`
 		# delete a backup named backup-name
@@ -109,6 +143,9 @@ var (
 	createRestoreExample = templates.Examples(`
 		# restore a new cluster from a backup
 		kbcli cluster restore new-cluster-name --backup backup-name
+
+		# restore a new cluster from a backup and wait for the restore to complete
+		kbcli cluster restore new-cluster-name --backup backup-name --wait
 	`)
 	describeBackupExample = templates.Examples(`
 		# describe a backup
@@ -132,12 +169,62 @@ type CreateBackupOptions struct {
 	OpsRequestName string              `json:"opsRequestName"`
 	Force          bool                `json:"force"`
 
+	// wait for the backup to complete or fail after it is created
+	Wait        bool          `json:"-"`
+	WaitTimeout time.Duration `json:"-"`
+	// MetricsPushgateway is the URL of the Prometheus push gateway to push the backup metrics to
+	MetricsPushgateway string `json:"-"`
+	// BackupGVR is the GVR of the backup resource, it is types.BackupGVR() if not set
+	BackupGVR schema.GroupVersionResource `json:"-"`
+
 	action.CreateOptions `json:"-"`
 }
 
+const (
+	backupOrderByName           = "name"
+	backupOrderByPhase          = "phase"
+	backupOrderByCreationTime   = "creationTime"
+	backupOrderByStartTime      = "startTime"
+	backupOrderByCompletionTime = "completionTime"
+	backupOrderBySize           = "size"
+)
+
+// backupFieldSelectorKeys are the fields supported by the field selector of backup,
+// the API server only supports these fields for custom resources.
+var backupFieldSelectorKeys = []string{"metadata.name", "metadata.namespace"}
+
+var backupPhases = []dpv1alpha1.BackupPhase{dpv1alpha1.BackupPhaseNew, dpv1alpha1.BackupPhaseRunning,
+	dpv1alpha1.BackupPhaseCompleted, dpv1alpha1.BackupPhaseFailed, dpv1alpha1.BackupPhaseDeleting}
+
+var backupOrderByKeys = []string{backupOrderByName, backupOrderByPhase, backupOrderByCreationTime, backupOrderByStartTime, backupOrderByCompletionTime, backupOrderBySize}
+
 type ListBackupOptions struct {
 	*action.ListOptions
 	BackupName string
+
+	// OrderBy is the key to sort the backups, Reverse reverses the sort order
+	OrderBy string
+	Reverse bool
+	// Watch watches the backups after listing them
+	Watch bool
+	// Since is a duration or an RFC3339 timestamp, only the backups started after it are shown
+	Since string
+	since time.Time
+	// Phases are the backup phases to filter on, the backups are filtered locally
+	// since the API server does not support field selectors on status.phase
+	Phases []string
+	phases map[dpv1alpha1.BackupPhase]bool
+	// Limit is the maximum number of backups to fetch from the server, Continue is the
+	// token returned by the previous list to fetch the next page
+	Limit    int64
+	Continue string
+	// WarnTTLHours marks the completed backups expiring within the given hours in the STATUS column,
+	// 0 disables the warning
+	WarnTTLHours int
+
+	// flags are the flags added by AddBackupFlags, they are used to check if the flags which only
+	// affect the table output are specified, since their default values are not zero
+	flags *pflag.FlagSet
 }
 
 type DescribeBackupOptions struct {
@@ -150,6 +237,9 @@ type DescribeBackupOptions struct {
 	Gvr   schema.GroupVersionResource
 	names []string
 
+	// MaxRetries is the max number of times to retry the requests failed with transient errors
+	MaxRetries int
+
 	genericiooptions.IOStreams
 }
 
@@ -157,6 +247,7 @@ func (o *CreateBackupOptions) CompleteBackup() error {
 	if err := o.Complete(); err != nil {
 		return err
 	}
+	o.BackupGVR = backupGVROrDefault(o.BackupGVR)
 	// generate backupName
 	if len(o.BackupSpec.BackupName) == 0 {
 		o.BackupSpec.BackupName = strings.Join([]string{"backup", o.Namespace, o.Name, time.Now().Format("20060102150405")}, "-")
@@ -175,6 +266,18 @@ func (o *CreateBackupOptions) Validate() error {
 		return fmt.Errorf("missing cluster name")
 	}
 
+	if o.MetricsPushgateway != "" && !o.Wait {
+		return fmt.Errorf("--metrics-pushgateway requires --wait to get the duration of the backup")
+	}
+
+	// check if the cluster exists
+	if _, err := o.Dynamic.Resource(types.ClusterGVR()).Namespace(o.Namespace).Get(context.TODO(), o.Name, metav1.GetOptions{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf(`cluster "%s" not found in namespace "%s"`, o.Name, o.Namespace)
+		}
+		return err
+	}
+
 	// if backup policy is not specified, use the default backup policy
 	if o.BackupSpec.BackupPolicyName == "" {
 		if err := o.completeDefaultBackupPolicy(); err != nil {
@@ -247,7 +350,7 @@ func (o *CreateBackupOptions) Validate() error {
 	// check if parent backup exists
 	if o.BackupSpec.ParentBackupName != "" {
 		parentBackup := &dpv1alpha1.Backup{}
-		if err := util.GetK8SClientObject(o.Dynamic, parentBackup, types.BackupGVR(), o.Namespace, o.BackupSpec.ParentBackupName); err != nil {
+		if err := util.GetK8SClientObject(o.Dynamic, parentBackup, o.BackupGVR, o.Namespace, o.BackupSpec.ParentBackupName); err != nil {
 			return err
 		}
 		if parentBackup.Status.Phase != dpv1alpha1.BackupPhaseCompleted {
@@ -271,7 +374,12 @@ func (o *CreateBackupOptions) completeDefaultBackupPolicy() error {
 }
 
 func (o *CreateBackupOptions) getDefaultBackupPolicy() (string, error) {
-	clusterObj, err := o.Dynamic.Resource(types.ClusterGVR()).Namespace(o.Namespace).Get(context.TODO(), o.Name, metav1.GetOptions{})
+	return GetDefaultBackupPolicy(o.Dynamic, o.Namespace, o.Name)
+}
+
+// GetDefaultBackupPolicy returns the name of the default backup policy of the cluster.
+func GetDefaultBackupPolicy(dynamic dynamic.Interface, namespace, clusterName string) (string, error) {
+	clusterObj, err := dynamic.Resource(types.ClusterGVR()).Namespace(namespace).Get(context.TODO(), clusterName, metav1.GetOptions{})
 	if err != nil {
 		return "", err
 	}
@@ -281,14 +389,14 @@ func (o *CreateBackupOptions) getDefaultBackupPolicy() (string, error) {
 		LabelSelector: fmt.Sprintf("%s=%s",
 			constant.AppInstanceLabelKey, clusterObj.GetName()),
 	}
-	objs, err := o.Dynamic.
-		Resource(types.BackupPolicyGVR()).Namespace(o.Namespace).
+	objs, err := dynamic.
+		Resource(types.BackupPolicyGVR()).Namespace(namespace).
 		List(context.TODO(), opts)
 	if err != nil {
 		return "", err
 	}
 	if len(objs.Items) == 0 {
-		return "", fmt.Errorf(`not found any backup policy for cluster "%s"`, o.Name)
+		return "", fmt.Errorf(`not found any backup policy for cluster "%s"`, clusterName)
 	}
 	var defaultBackupPolicies []unstructured.Unstructured
 	for _, obj := range objs.Items {
@@ -297,10 +405,10 @@ func (o *CreateBackupOptions) getDefaultBackupPolicy() (string, error) {
 		}
 	}
 	if len(defaultBackupPolicies) == 0 {
-		return "", fmt.Errorf(`not found any default backup policy for cluster "%s"`, o.Name)
+		return "", fmt.Errorf(`not found any default backup policy for cluster "%s"`, clusterName)
 	}
 	if len(defaultBackupPolicies) > 1 {
-		return "", fmt.Errorf(`cluster "%s" has multiple default backup policies`, o.Name)
+		return "", fmt.Errorf(`cluster "%s" has multiple default backup policies`, clusterName)
 	}
 	return defaultBackupPolicies[0].GetName(), nil
 }
@@ -335,6 +443,7 @@ func NewCreateBackupCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *
 			cmdutil.CheckErr(o.CompleteBackup())
 			cmdutil.CheckErr(o.Validate())
 			cmdutil.CheckErr(o.Run())
+			cmdutil.CheckErr(o.WaitBackup())
 		},
 	}
 
@@ -344,10 +453,166 @@ func NewCreateBackupCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *
 	cmd.Flags().StringVar(&o.BackupSpec.DeletionPolicy, "deletion-policy", "Delete", "Deletion policy for backup, determine whether the backup content in backup repo will be deleted after the backup is deleted, supported values: [Delete, Retain]")
 	cmd.Flags().StringVar(&o.BackupSpec.RetentionPeriod, "retention-period", "", "Retention period for backup, supported values: [1y, 1mo, 1d, 1h, 1m] or combine them [1y1mo1d1h1m], if not specified, the backup will not be automatically deleted, you need to manually delete it.")
 	cmd.Flags().StringVar(&o.BackupSpec.ParentBackupName, "parent-backup", "", "Parent backup name, used for incremental backup")
+	o.AddDryRunFlags(cmd)
+	o.AddWaitFlags(cmd)
 	// register backup flag completion func
 	o.RegisterBackupFlagCompletionFunc(cmd, f)
 	return cmd
 }
+
+// AddWaitFlags adds flags for waiting the backup to complete and pushing its metrics
+func (o *CreateBackupOptions) AddWaitFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.Wait, "wait", false, "Wait for the backup to complete or fail")
+	cmd.Flags().DurationVar(&o.WaitTimeout, "wait-timeout", time.Hour, "Time to wait for the backup to complete, only valid if --wait is true, such as --wait-timeout=30m")
+	cmd.Flags().StringVar(&o.MetricsPushgateway, "metrics-pushgateway", "", "The URL of the Prometheus push gateway, push the metric kbcli_backup_duration_seconds to it after the backup completes or fails, requires --wait")
+}
+
+// WaitBackup waits for the created backup to complete if --wait is specified,
+// it returns an error if the backup failed or timed out.
+func (o *CreateBackupOptions) WaitBackup() error {
+	if !o.Wait {
+		return nil
+	}
+	if dryRun, err := o.GetDryRunStrategy(); err != nil || dryRun != action.DryRunNone {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), o.WaitTimeout)
+	defer cancel()
+	backup, err := waitBackupCompleted(ctx, o.Dynamic, o.BackupGVR, o.Out, o.Namespace, o.BackupSpec.BackupName, o.OpsRequestName)
+	if backup != nil && o.MetricsPushgateway != "" {
+		// the backup result is more important than the metrics, only warn if the push fails
+		if pushErr := pushBackupMetrics(o.MetricsPushgateway, o.ClusterName, backup); pushErr != nil {
+			fmt.Fprintf(o.ErrOut, "failed to push the backup metrics to %s: %v\n", o.MetricsPushgateway, pushErr)
+		}
+	}
+	return err
+}
+
+// pushBackupMetrics pushes the duration of the completed or failed backup to the Prometheus push gateway,
+// the metrics are grouped by the cluster, so the latest backup of a cluster replaces the previous one.
+func pushBackupMetrics(url, clusterName string, backup *dpv1alpha1.Backup) error {
+	var seconds float64
+	if d := backupDuration(backup, time.Now()); d != nil {
+		seconds = d.Seconds()
+	}
+	durationGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kbcli_backup_duration_seconds",
+		Help: "The duration of the backup created by kbcli in seconds.",
+	}, []string{"cluster", "phase", "method"})
+	durationGauge.WithLabelValues(clusterName, string(backup.Status.Phase), backup.Spec.BackupMethod).Set(seconds)
+	return push.New(url, "kbcli_backup").
+		Grouping("instance", backup.Namespace+"/"+clusterName).
+		Collector(durationGauge).
+		Push()
+}
+
+// backupGVROrDefault returns the given GVR of the backup resource, which is overridden by the flags
+// of the data protection command, or types.BackupGVR() if it is not set.
+func backupGVROrDefault(gvr schema.GroupVersionResource) schema.GroupVersionResource {
+	if gvr.Empty() {
+		return types.BackupGVR()
+	}
+	return gvr
+}
+
+// waitBackupCompleted watches the backup until its phase is Completed or Failed, and prints
+// the elapsed time and the phase when it changes. The backup is created by the OpsRequest
+// controller, so it may not exist when the watch starts, and the OpsRequest is watched too so
+// that it does not wait for a backup which is never created. The last observed backup is
+// returned if it completes or fails.
+func waitBackupCompleted(ctx context.Context, dynamic dynamic.Interface, backupGVR schema.GroupVersionResource, out io.Writer, namespace, name, opsName string) (*dpv1alpha1.Backup, error) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	if opsName != "" {
+		go func() {
+			if err := waitBackupOpsRequest(ctx, dynamic, namespace, opsName); err != nil && ctx.Err() == nil {
+				cancel(err)
+			}
+		}()
+	}
+
+	start := time.Now()
+	var (
+		lastPhase dpv1alpha1.BackupPhase
+		backup    *dpv1alpha1.Backup
+	)
+	err := watchObjectUntil(ctx, dynamic.Resource(backupGVR).Namespace(namespace), name, nil, func(event watch.Event) (bool, error) {
+		if event.Type == watch.Deleted {
+			return false, fmt.Errorf("backup %s is deleted", name)
+		}
+		obj, ok := event.Object.(*unstructured.Unstructured)
+		if !ok {
+			return false, nil
+		}
+		backup = &dpv1alpha1.Backup{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, backup); err != nil {
+			return false, err
+		}
+		phase := backup.Status.Phase
+		if phase == "" || phase == lastPhase {
+			return false, nil
+		}
+		lastPhase = phase
+		fmt.Fprintf(out, "[%s] Backup %s is %s\n", duration.HumanDuration(time.Since(start)), name, phase)
+		switch phase {
+		case dpv1alpha1.BackupPhaseCompleted:
+			return true, nil
+		case dpv1alpha1.BackupPhaseFailed:
+			return false, fmt.Errorf("backup %s failed: %s, run \"kbcli cluster describe-backup %s -n %s\" to view the details",
+				name, util.CheckEmpty(backup.Status.FailureReason), name, namespace)
+		}
+		return false, nil
+	})
+	switch {
+	case err == nil:
+		return backup, nil
+	case ctx.Err() == nil:
+		if lastPhase == dpv1alpha1.BackupPhaseFailed {
+			return backup, err
+		}
+		return nil, err
+	}
+	// the context is cancelled by the failed OpsRequest, or the wait timed out
+	if cause := context.Cause(ctx); !errors.Is(cause, context.DeadlineExceeded) && !errors.Is(cause, context.Canceled) {
+		return nil, cause
+	}
+	return nil, fmt.Errorf("timed out waiting for backup %s to complete, run \"kbcli cluster describe-backup %s -n %s\" to view the details", name, name, namespace)
+}
+
+// waitBackupOpsRequest watches the OpsRequest which creates the backup, it returns an error if
+// the OpsRequest fails or is cancelled, and returns nil once it succeeds or is deleted.
+func waitBackupOpsRequest(ctx context.Context, dynamic dynamic.Interface, namespace, name string) error {
+	return watchObjectUntil(ctx, dynamic.Resource(types.OpsGVR()).Namespace(namespace), name, nil, func(event watch.Event) (bool, error) {
+		if event.Type == watch.Deleted {
+			return true, nil
+		}
+		obj, ok := event.Object.(*unstructured.Unstructured)
+		if !ok {
+			return false, nil
+		}
+		ops := &appsv1alpha1.OpsRequest{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, ops); err != nil {
+			return false, err
+		}
+		switch ops.Status.Phase {
+		case appsv1alpha1.OpsSucceedPhase:
+			return true, nil
+		case appsv1alpha1.OpsFailedPhase, appsv1alpha1.OpsCancelledPhase:
+			return false, fmt.Errorf("OpsRequest %s of backup is %s, run \"kbcli cluster describe-ops %s -n %s\" to view the details",
+				name, ops.Status.Phase, name, namespace)
+		}
+		return false, nil
+	})
+}
+
+// AddDryRunFlags adds the --dry-run, --output and --audit-log flags for creating backup
+func (o *CreateBackupOptions) AddDryRunFlags(cmd *cobra.Command) {
+	printer.AddOutputFlagForCreate(cmd, &o.Format, false)
+	cmd.Flags().StringVar(&o.DryRun, "dry-run", "none", `Must be "client", or "server". If with client strategy, only print the object that would be sent, and no data is actually sent. If with server strategy, submit the server-side request, but no data is persistent.`)
+	cmd.Flags().Lookup("dry-run").NoOptDefVal = "unchanged"
+	o.AddAuditLogFlag(cmd, false)
+}
+
 func (o *CreateBackupOptions) RegisterBackupFlagCompletionFunc(cmd *cobra.Command, f cmdutil.Factory) {
 	util.CheckErr(cmd.RegisterFlagCompletionFunc(
 		"deletion-policy",
@@ -392,20 +657,78 @@ func (o *CreateBackupOptions) RegisterBackupFlagCompletionFunc(cmd *cobra.Comman
 		}))
 }
 
-func PrintBackupList(o ListBackupOptions) error {
-	var backupNameMap = make(map[string]bool)
-	for _, name := range o.Names {
-		backupNameMap[name] = true
+// Complete completes the list options and checks that the CRD of the backups is installed, so a missing
+// CRD is reported with an actionable error instead of the error of the resource builder.
+func (o *ListBackupOptions) Complete() error {
+	if err := o.ListOptions.Complete(); err != nil {
+		return err
+	}
+	discoveryClient, err := o.Factory.ToDiscoveryClient()
+	if err != nil {
+		return err
+	}
+	resources, err := discoveryClient.ServerResourcesForGroupVersion(o.GVR.GroupVersion().String())
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if resources != nil {
+		for _, r := range resources.APIResources {
+			if r.Name == o.GVR.Resource {
+				return nil
+			}
+		}
 	}
+	return fmt.Errorf("the CRD of %s is not found. Is KubeBlocks installed? Run: kbcli kubeblocks install", o.GVR.GroupResource())
+}
 
-	// if format is JSON or YAML, use default printer to output the result.
-	if o.Format == printer.JSON || o.Format == printer.YAML {
+func PrintBackupList(o ListBackupOptions) error {
+	ctx := o.Ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	// cancel the requests if the user interrupts the command
+	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	// if format is JSON, YAML or template, use default printer to output the result.
+	if o.Format == printer.JSON || o.Format == printer.YAML || o.Format == printer.Template {
+		if o.Watch {
+			return fmt.Errorf("--watch is only supported with table or wide output format")
+		}
+		if o.Since != "" || len(o.Phases) > 0 || o.Limit > 0 || o.Continue != "" {
+			return fmt.Errorf("--since, --phase, --limit and --continue are only supported with table or wide output format")
+		}
+		if o.flags != nil && (o.flags.Changed("sort-by") || o.flags.Changed("reverse") || o.flags.Changed("warn-ttl-hours")) {
+			return fmt.Errorf("--sort-by, --reverse and --warn-ttl-hours are only supported with table or wide output format")
+		}
 		if o.BackupName != "" {
 			o.Names = []string{o.BackupName}
 		}
+		o.DecorateObject = setBackupAge
+		o.Ctx = ctx
 		_, err := o.Run()
 		return err
 	}
+	if o.Limit < 0 {
+		return fmt.Errorf("--limit must not be negative")
+	}
+	if o.WarnTTLHours < 0 {
+		return fmt.Errorf("--warn-ttl-hours must not be negative")
+	}
+	if o.Watch && (o.Limit > 0 || o.Continue != "") {
+		return fmt.Errorf("--watch can not be used with --limit or --continue")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	since, err := parseSince(o.Since, time.Now())
+	if err != nil {
+		return err
+	}
+	o.since = since
+	if o.phases, err = parsePhases(o.Phases); err != nil {
+		return err
+	}
 	dynamic, err := o.Factory.DynamicClient()
 	if err != nil {
 		return err
@@ -413,38 +736,125 @@ func PrintBackupList(o ListBackupOptions) error {
 	if o.AllNamespaces {
 		o.Namespace = ""
 	}
-	backupList, err := dynamic.Resource(types.BackupGVR()).Namespace(o.Namespace).List(context.TODO(), metav1.ListOptions{
+	client := dynamic.Resource(o.GVR).Namespace(o.Namespace)
+	// show a spinner while fetching the backups, it is removed before printing the table
+	var s spinner.Interface
+	if util.IsTerminal(o.Out) {
+		s = spinner.New(o.Out, spinner.WithMessage("Fetching backups"))
+	}
+	backupList, err := listBackups(ctx, client, metav1.ListOptions{
 		LabelSelector: o.LabelSelector,
 		FieldSelector: o.FieldSelector,
-	})
+		Limit:         o.Limit,
+		Continue:      o.Continue,
+	}, o.ErrOut)
+	if s != nil {
+		s.Done("")
+	}
 	if err != nil {
+		if o.FieldSelector != "" && apierrors.IsBadRequest(err) {
+			return fmt.Errorf("invalid field selector \"%s\", supported fields: [%s]: %v", o.FieldSelector, strings.Join(backupFieldSelectorKeys, ", "), err)
+		}
+		return err
+	}
+
+	if err = printBackupTable(o, backupList.Items); err != nil {
 		return err
 	}
+	klog.V(1).InfoS("Listed backups", "namespace", o.Namespace, "labelSelector", o.LabelSelector,
+		"fieldSelector", o.FieldSelector, "count", len(backupList.Items), "continue", backupList.GetContinue())
+	if token := backupList.GetContinue(); token != "" {
+		fmt.Fprintf(o.Out, "\nThere are more backups, use \"--continue %s\" to list the next page.\n", token)
+	}
+	if !o.Watch {
+		return nil
+	}
+	return watchBackups(ctx, o, client, backupList)
+}
 
-	if len(backupList.Items) == 0 {
+// listBackups lists the backups, if the API server rejects the page size specified by --limit,
+// it retries with a halved page size until the request succeeds or the page size is 1.
+func listBackups(ctx context.Context, client dynamic.ResourceInterface, opts metav1.ListOptions, errOut io.Writer) (*unstructured.UnstructuredList, error) {
+	for {
+		backupList, err := client.List(ctx, opts)
+		if err == nil || opts.Limit <= 1 || !isLimitRejected(err) {
+			return backupList, err
+		}
+		limit := opts.Limit / 2
+		fmt.Fprintf(errOut, "warning: failed to list backups with page size %d, retry with page size %d: %v\n", opts.Limit, limit, err)
+		opts.Limit = limit
+	}
+}
+
+// isLimitRejected checks if the list request is rejected because of the page size, only the status
+// causes are checked, the other rejected requests, such as an invalid label or field selector, will
+// not succeed with a smaller page size.
+func isLimitRejected(err error) bool {
+	if !apierrors.IsBadRequest(err) && !apierrors.IsInvalid(err) && !apierrors.IsForbidden(err) {
+		return false
+	}
+	status, ok := err.(apierrors.APIStatus)
+	if !ok || status.Status().Details == nil {
+		return false
+	}
+	for _, cause := range status.Status().Details.Causes {
+		field := strings.ToLower(cause.Field)
+		if strings.Contains(field, "limit") || strings.Contains(field, "quota") {
+			return true
+		}
+	}
+	return false
+}
+
+func printBackupTable(o ListBackupOptions, items []unstructured.Unstructured) error {
+	if len(items) == 0 {
 		o.PrintNotFoundResources()
 		return nil
 	}
 
-	// sort the unstructured objects with the creationTimestamp in positive order
-	sort.Sort(unstructuredList(backupList.Items))
-	tbl := printer.NewTablePrinter(o.Out)
-	tbl.SetHeader("NAME", "NAMESPACE", "SOURCE-CLUSTER", "METHOD", "STATUS", "TOTAL-SIZE", "DURATION", "CREATE-TIME", "COMPLETION-TIME", "EXPIRATION")
-	for _, obj := range backupList.Items {
+	var backupNameMap = make(map[string]bool)
+	for _, name := range o.Names {
+		backupNameMap[name] = true
+	}
+	var backups []*dpv1alpha1.Backup
+	for _, obj := range items {
 		backup := &dpv1alpha1.Backup{}
-		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, backup); err != nil {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, backup); err != nil {
 			return err
 		}
+		if len(o.Names) > 0 && !backupNameMap[backup.Name] {
+			continue
+		}
+		if !o.since.IsZero() && backupStartTime(backup).Before(o.since) {
+			continue
+		}
+		if len(o.phases) > 0 && !o.phases[backup.Status.Phase] {
+			continue
+		}
+		backups = append(backups, backup)
+	}
+	klog.V(2).InfoS("Filtered backups", "names", o.Names, "since", o.Since, "phases", o.Phases,
+		"total", len(items), "shown", len(backups))
+	if err := sortBackups(backups, o.OrderBy, o.Reverse); err != nil {
+		return err
+	}
+
+	// the wide format shows the labels of backup in addition
+	showLabels := o.ShowLabels || o.Format == printer.Wide
+	header := []interface{}{"NAME", "NAMESPACE", "SOURCE-CLUSTER", "METHOD", "STATUS", "TOTAL-SIZE", "DURATION", "CREATE-TIME", "COMPLETION-TIME", "EXPIRATION", "AGE"}
+	if showLabels {
+		header = append(header, "LABELS")
+	}
+	tbl := printer.NewTablePrinter(o.Out)
+	tbl.SetHeader(header...)
+	for _, backup := range backups {
 		// TODO(ldm): find cluster from backup policy target spec.
 		sourceCluster := backup.Labels[constant.AppInstanceLabelKey]
 		durationStr := ""
-		if backup.Status.Duration != nil {
-			durationStr = duration.HumanDuration(backup.Status.Duration.Duration)
-		}
-		statusString := string(backup.Status.Phase)
-		if len(o.Names) > 0 && !backupNameMap[backup.Name] {
-			continue
+		if d := backupDuration(backup, time.Now()); d != nil {
+			durationStr = duration.HumanDuration(*d)
 		}
+		statusString := colorBackupPhase(backup.Status.Phase)
 		var availableReplicas *int32
 		for _, v := range backup.Status.Actions {
 			if v.ActionType == dpv1alpha1.ActionTypeStatefulSet {
@@ -455,14 +865,348 @@ func PrintBackupList(o ListBackupOptions) error {
 		if availableReplicas != nil {
 			statusString = fmt.Sprintf("%s(AvailablePods: %d)", statusString, *availableReplicas)
 		}
-		tbl.AddRow(backup.Name, backup.Namespace, sourceCluster, backup.Spec.BackupMethod, statusString, backup.Status.TotalSize,
+		if backupExpiringSoon(backup, time.Now(), time.Duration(o.WarnTTLHours)*time.Hour) {
+			statusString = fmt.Sprintf("%s(EXPIRING SOON)", statusString)
+		}
+		_, totalSize := backupSize(backup)
+		row := []interface{}{backup.Name, backup.Namespace, sourceCluster, backup.Spec.BackupMethod, statusString, totalSize,
 			durationStr, util.TimeFormat(&backup.CreationTimestamp), util.TimeFormat(backup.Status.CompletionTimestamp),
-			util.TimeFormat(backup.Status.Expiration))
+			util.TimeFormat(backup.Status.Expiration), backupAge(backup, time.Now())}
+		if showLabels {
+			row = append(row, util.CombineLabels(backup.Labels))
+		}
+		tbl.AddRow(row...)
 	}
 	tbl.Print()
 	return nil
 }
 
+// colorBackupPhase renders the backup phase in color, green for Completed, yellow for New and Running
+// and red for Failed. The color is disabled if stdout is not a terminal, NO_COLOR is set or --no-color
+// is specified.
+func colorBackupPhase(phase dpv1alpha1.BackupPhase) string {
+	switch phase {
+	case dpv1alpha1.BackupPhaseCompleted:
+		return printer.BoldGreen(phase)
+	case dpv1alpha1.BackupPhaseNew, dpv1alpha1.BackupPhaseRunning:
+		return printer.BoldYellow(phase)
+	case dpv1alpha1.BackupPhaseFailed:
+		return printer.BoldRed(phase)
+	default:
+		return string(phase)
+	}
+}
+
+// backupSize parses the total size of backup, which may be a number of bytes such as "1073741824"
+// or a size with unit such as "1.5GiB", and returns the bytes and the human-readable size. The raw
+// total size is returned if it can not be parsed.
+func backupSize(backup *dpv1alpha1.Backup) (int64, string) {
+	if backup.Status.TotalSize == "" {
+		return 0, ""
+	}
+	size, err := humanize.ParseBytes(backup.Status.TotalSize)
+	if err != nil || size > math.MaxInt64 {
+		klog.V(1).Infof("failed to parse the total size %s of backup %s: %v", backup.Status.TotalSize, backup.Name, err)
+		return 0, backup.Status.TotalSize
+	}
+	return int64(size), humanize.IBytes(size)
+}
+
+// parseSince parses the value of --since, which is a duration relative to now such as 24h,
+// or an RFC3339 timestamp. It returns the zero time if since is empty.
+func parseSince(since string, now time.Time) (time.Time, error) {
+	if since == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(since); err == nil {
+		if d < 0 {
+			return time.Time{}, fmt.Errorf("invalid --since \"%s\", the duration must not be negative", since)
+		}
+		return now.Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --since \"%s\", it should be a duration such as 24h or an RFC3339 timestamp such as 2006-01-02T15:04:05Z", since)
+	}
+	return t, nil
+}
+
+// parsePhases validates the values of --phase and returns them as a set, the values are case-insensitive.
+func parsePhases(phases []string) (map[dpv1alpha1.BackupPhase]bool, error) {
+	if len(phases) == 0 {
+		return nil, nil
+	}
+	res := make(map[dpv1alpha1.BackupPhase]bool, len(phases))
+	for _, p := range phases {
+		p = strings.TrimSpace(p)
+		idx := slices.IndexFunc(backupPhases, func(phase dpv1alpha1.BackupPhase) bool {
+			return strings.EqualFold(string(phase), p)
+		})
+		if idx < 0 {
+			return nil, fmt.Errorf("invalid backup phase \"%s\", supported values: %v", p, backupPhases)
+		}
+		res[backupPhases[idx]] = true
+	}
+	return res, nil
+}
+
+// backupStartTime returns the start timestamp of the backup, the backup which has not
+// started yet uses its creation timestamp instead.
+func backupStartTime(backup *dpv1alpha1.Backup) time.Time {
+	if backup.Status.StartTimestamp != nil {
+		return backup.Status.StartTimestamp.Time
+	}
+	return backup.CreationTimestamp.Time
+}
+
+// backupDuration returns the duration of the backup. If status.duration is not set,
+// it is computed from the start and completion timestamps, a running backup without
+// completion timestamp is measured until now. It returns nil if the backup has not started.
+func backupDuration(backup *dpv1alpha1.Backup, now time.Time) *time.Duration {
+	if backup.Status.Duration != nil {
+		return &backup.Status.Duration.Duration
+	}
+	if backup.Status.StartTimestamp == nil {
+		return nil
+	}
+	end := now
+	if backup.Status.CompletionTimestamp != nil {
+		end = backup.Status.CompletionTimestamp.Time
+	} else if backup.Status.Phase != dpv1alpha1.BackupPhaseRunning {
+		return nil
+	}
+	d := end.Sub(backup.Status.StartTimestamp.Time)
+	if d < 0 {
+		return nil
+	}
+	return &d
+}
+
+// backupAge returns the human-readable time since the backup started, it is empty
+// if the backup has not started yet.
+func backupAge(backup *dpv1alpha1.Backup, now time.Time) string {
+	if backup.Status.StartTimestamp == nil {
+		return ""
+	}
+	return duration.HumanDuration(now.Sub(backup.Status.StartTimestamp.Time))
+}
+
+// backupExpiration returns the expiration time of the backup. It is the status.expiration set by
+// the controller, or the start time plus the retention period if the status is not updated yet.
+func backupExpiration(backup *dpv1alpha1.Backup) *time.Time {
+	if backup.Status.Expiration != nil {
+		return &backup.Status.Expiration.Time
+	}
+	if backup.Spec.RetentionPeriod == "" || backup.Status.StartTimestamp == nil {
+		return nil
+	}
+	retention, err := backup.Spec.RetentionPeriod.ToDuration()
+	if err != nil || retention == 0 {
+		return nil
+	}
+	expiration := backup.Status.StartTimestamp.Add(retention)
+	return &expiration
+}
+
+// backupExpiringSoon returns true if the backup is completed and will expire within the given duration.
+func backupExpiringSoon(backup *dpv1alpha1.Backup, now time.Time, within time.Duration) bool {
+	if within <= 0 || backup.Status.Phase != dpv1alpha1.BackupPhaseCompleted {
+		return false
+	}
+	expiration := backupExpiration(backup)
+	if expiration == nil || expiration.Before(now) {
+		return false
+	}
+	return expiration.Sub(now) <= within
+}
+
+// setBackupAge sets the derived status.age of the backup object, so the JSON and YAML
+// output contain the same AGE as the table.
+func setBackupAge(obj *unstructured.Unstructured) error {
+	backup := &dpv1alpha1.Backup{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, backup); err != nil {
+		return err
+	}
+	age := backupAge(backup, time.Now())
+	if age == "" {
+		return nil
+	}
+	return unstructured.SetNestedField(obj.Object, age, "status", "age")
+}
+
+// watchBackups watches the backups and reprints the table when any of them changes.
+// If the watch fails, it relists the backups and watches again with exponential back-off,
+// until the context is done.
+func watchBackups(ctx context.Context, o ListBackupOptions, client dynamic.ResourceInterface, backupList *unstructured.UnstructuredList) error {
+	var (
+		backups         map[string]unstructured.Unstructured
+		resourceVersion string
+		backoff         = wait.Backoff{Duration: time.Second, Factor: 2, Jitter: 0.1, Steps: math.MaxInt32, Cap: 30 * time.Second}
+	)
+	resetBackups := func(list *unstructured.UnstructuredList) {
+		backups = make(map[string]unstructured.Unstructured, len(list.Items))
+		for _, item := range list.Items {
+			backups[item.GetNamespace()+"/"+item.GetName()] = item
+		}
+		resourceVersion = list.GetResourceVersion()
+	}
+	reprint := func() error {
+		if util.IsTerminal(o.Out) {
+			// clear the screen and move the cursor to the top left
+			fmt.Fprint(o.Out, "\033[H\033[2J")
+		} else {
+			fmt.Fprintln(o.Out)
+		}
+		return printBackupTable(o, maps.Values(backups))
+	}
+	watchOnce := func() error {
+		w, err := client.Watch(ctx, metav1.ListOptions{
+			LabelSelector:   o.LabelSelector,
+			FieldSelector:   o.FieldSelector,
+			ResourceVersion: resourceVersion,
+		})
+		if err != nil {
+			return err
+		}
+		defer w.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case event, ok := <-w.ResultChan():
+				if !ok {
+					// the watch is closed by server, watch again from the last resource version
+					return nil
+				}
+				if event.Type == watch.Error {
+					return apierrors.FromObject(event.Object)
+				}
+				obj, ok := event.Object.(*unstructured.Unstructured)
+				if !ok {
+					continue
+				}
+				resourceVersion = obj.GetResourceVersion()
+				key := obj.GetNamespace() + "/" + obj.GetName()
+				switch event.Type {
+				case watch.Added, watch.Modified:
+					backups[key] = *obj
+				case watch.Deleted:
+					delete(backups, key)
+				default:
+					continue
+				}
+				if err = reprint(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	resetBackups(backupList)
+	for {
+		err := watchOnce()
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err == nil {
+			continue
+		}
+		delay := backoff.Step()
+		klog.V(1).InfoS("Failed to watch backups, retrying", "delay", delay, "err", err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+		if list, err := client.List(ctx, metav1.ListOptions{
+			LabelSelector: o.LabelSelector,
+			FieldSelector: o.FieldSelector,
+		}); err == nil {
+			resetBackups(list)
+		}
+	}
+}
+
+// sortBackups sorts the backups by the specified key, the backups with the same key
+// keep their original order, which is sorted by the creation timestamp.
+func sortBackups(backups []*dpv1alpha1.Backup, orderBy string, reverse bool) error {
+	timeOf := func(t *metav1.Time) time.Time {
+		if t == nil {
+			return time.Time{}
+		}
+		return t.Time
+	}
+	var less func(i, j *dpv1alpha1.Backup) bool
+	switch orderBy {
+	case "", backupOrderByCreationTime:
+		less = func(i, j *dpv1alpha1.Backup) bool {
+			return i.CreationTimestamp.Before(&j.CreationTimestamp)
+		}
+	case backupOrderByName:
+		less = func(i, j *dpv1alpha1.Backup) bool {
+			return i.Name < j.Name
+		}
+	case backupOrderByPhase:
+		less = func(i, j *dpv1alpha1.Backup) bool {
+			return i.Status.Phase < j.Status.Phase
+		}
+	case backupOrderByStartTime:
+		less = func(i, j *dpv1alpha1.Backup) bool {
+			return timeOf(i.Status.StartTimestamp).Before(timeOf(j.Status.StartTimestamp))
+		}
+	case backupOrderByCompletionTime:
+		less = func(i, j *dpv1alpha1.Backup) bool {
+			return timeOf(i.Status.CompletionTimestamp).Before(timeOf(j.Status.CompletionTimestamp))
+		}
+	case backupOrderBySize:
+		less = func(i, j *dpv1alpha1.Backup) bool {
+			iSize, _ := backupSize(i)
+			jSize, _ := backupSize(j)
+			return iSize < jSize
+		}
+	default:
+		return fmt.Errorf("invalid sort key \"%s\", supported values: [%s]", orderBy, strings.Join(backupOrderByKeys, ", "))
+	}
+
+	// sort by the creation timestamp first to make the result stable
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].CreationTimestamp.Before(&backups[j].CreationTimestamp)
+	})
+	sort.SliceStable(backups, func(i, j int) bool {
+		if reverse {
+			return less(backups[j], backups[i])
+		}
+		return less(backups[i], backups[j])
+	})
+	return nil
+}
+
+// AddBackupFlags adds the flags that are only used to list backups.
+func (o *ListBackupOptions) AddBackupFlags(cmd *cobra.Command) {
+	o.flags = cmd.Flags()
+	cmd.Flags().StringVar(&o.OrderBy, "sort-by", backupOrderByCreationTime, fmt.Sprintf("Sort the backups by the specified key, supported values: [%s]", strings.Join(backupOrderByKeys, ", ")))
+	cmd.Flags().BoolVar(&o.Reverse, "reverse", false, "If true, reverse the sort order of backups")
+	cmd.Flags().BoolVarP(&o.Watch, "watch", "w", false, "After listing the backups, watch for changes and reprint the backups")
+	cmd.Flags().StringSliceVar(&o.Phases, "phase", nil, fmt.Sprintf("Only list the backups in the given phases, separated by comma, supported values: %v. The backups are fetched and filtered locally", backupPhases))
+	cmd.Flags().Int64Var(&o.Limit, "limit", 0, "The maximum number of backups to fetch from the server, 0 means no limit. The filters such as --since and --phase are applied to the fetched backups")
+	cmd.Flags().StringVar(&o.Continue, "continue", "", "The continue token returned by the previous list with --limit, to list the next page of backups")
+	cmd.Flags().StringVar(&o.Since, "since", "", "Only list the backups started after the given time, either a relative duration like 24h or an RFC3339 timestamp like 2006-01-02T15:04:05Z")
+	cmd.Flags().IntVar(&o.WarnTTLHours, "warn-ttl-hours", 24, "Mark the completed backups that expire within the given hours as EXPIRING SOON in the STATUS column, 0 disables the warning")
+	cmd.Flags().StringVar(&o.FieldSelector, "field-selector", o.FieldSelector, fmt.Sprintf("Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector metadata.name=mybackup). Supported fields: [%s]", strings.Join(backupFieldSelectorKeys, ", ")))
+	util.CheckErr(cmd.RegisterFlagCompletionFunc("sort-by",
+		func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return backupOrderByKeys, cobra.ShellCompDirectiveNoFileComp
+		}))
+	util.CheckErr(cmd.RegisterFlagCompletionFunc("phase",
+		func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			var phases []string
+			for _, p := range backupPhases {
+				phases = append(phases, string(p))
+			}
+			return phases, cobra.ShellCompDirectiveNoFileComp
+		}))
+}
+
 func NewListBackupCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
 	o := &ListBackupOptions{ListOptions: action.NewListOptions(f, streams, types.BackupGVR())}
 	cmd := &cobra.Command{
@@ -477,11 +1221,13 @@ func NewListBackupCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *co
 				o.Names = []string{o.BackupName}
 			}
 			cmdutil.BehaviorOnFatal(printer.FatalWithRedColor)
+			o.Ctx = cmd.Context()
 			util.CheckErr(o.Complete())
 			util.CheckErr(PrintBackupList(*o))
 		},
 	}
 	o.AddFlags(cmd)
+	o.AddBackupFlags(cmd)
 	cmd.Flags().StringVar(&o.BackupName, "name", "", "The backup name to get the details.")
 	return cmd
 }
@@ -504,11 +1250,18 @@ func NewDescribeBackupCmd(f cmdutil.Factory, streams genericiooptions.IOStreams)
 			util.CheckErr(o.Run())
 		},
 	}
+	o.AddFlags(cmd)
 	return cmd
 }
 
+// AddFlags adds the flags for describing backups
+func (o *DescribeBackupOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&o.MaxRetries, "max-retries", util.DefaultMaxRetries, "The max number of times to retry the requests failed with transient errors like 429 Too Many Requests and 503 Service Unavailable")
+}
+
 func NewDeleteBackupCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
 	o := action.NewDeleteOptions(f, streams, types.BackupGVR())
+	o.PreDeleteHook = PreDeleteBackup
 	cmd := &cobra.Command{
 		Use:               "delete-backup",
 		Short:             "Delete a backup.",
@@ -525,6 +1278,24 @@ func NewDeleteBackupCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *
 	return cmd
 }
 
+// PreDeleteBackup checks if the backup is still in progress, the in-progress backup
+// can only be deleted with --force.
+func PreDeleteBackup(o *action.DeleteOptions, obj runtime.Object) error {
+	unstructured := obj.(*unstructured.Unstructured)
+	backup := &dpv1alpha1.Backup{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructured.Object, backup); err != nil {
+		return err
+	}
+	if backup.Status.Phase != dpv1alpha1.BackupPhaseNew && backup.Status.Phase != dpv1alpha1.BackupPhaseRunning {
+		return nil
+	}
+	if !o.Force {
+		return fmt.Errorf(`backup "%s" is %s, you can specify "--force" to delete it`, backup.Name, backup.Status.Phase)
+	}
+	fmt.Fprintf(o.ErrOut, "warning: Backup %s is %s, it will be deleted by force.\n", backup.Name, backup.Status.Phase)
+	return nil
+}
+
 // completeForDeleteBackup completes cmd for delete backup
 func completeForDeleteBackup(o *action.DeleteOptions, args []string) error {
 	if len(args) == 0 {
@@ -553,6 +1324,12 @@ type CreateRestoreOptions struct {
 	OpsRequestName string               `json:"opsRequestName"`
 	Force          bool                 `json:"force"`
 
+	// Wait for the restore OpsRequest to complete
+	Wait    bool          `json:"-"`
+	Timeout time.Duration `json:"-"`
+	// BackupGVR is the GVR of the backup resource, it is types.BackupGVR() if not set
+	BackupGVR schema.GroupVersionResource `json:"-"`
+
 	action.CreateOptions `json:"-"`
 }
 
@@ -561,6 +1338,16 @@ func (o *CreateRestoreOptions) Validate() error {
 		return fmt.Errorf("must be specified one of the --backup ")
 	}
 
+	// check the backup exists and is completed, continuous backups used for PITR are always running
+	o.BackupGVR = backupGVROrDefault(o.BackupGVR)
+	backup := &dpv1alpha1.Backup{}
+	if err := util.GetK8SClientObject(o.Dynamic, backup, o.BackupGVR, o.Namespace, o.RestoreSpec.BackupName); err != nil {
+		return err
+	}
+	if o.RestoreSpec.RestorePointInTime == "" && backup.Status.Phase != dpv1alpha1.BackupPhaseCompleted {
+		return fmt.Errorf(`backup "%s" is %s, only completed backup can be used to restore`, backup.Name, util.CheckEmpty(string(backup.Status.Phase)))
+	}
+
 	if o.Name == "" {
 		name, err := generateClusterName(o.Dynamic, o.Namespace)
 		if err != nil {
@@ -580,6 +1367,23 @@ func (o *CreateRestoreOptions) Validate() error {
 	return nil
 }
 
+// WaitRestore waits for the restore OpsRequest to complete if --wait is specified
+func (o *CreateRestoreOptions) WaitRestore() error {
+	if !o.Wait {
+		return nil
+	}
+	if dryRun, err := o.GetDryRunStrategy(); err != nil || dryRun != action.DryRunNone {
+		return err
+	}
+	return waitOpsRequest(o.Dynamic, o.Out, o.Namespace, o.OpsRequestName, o.Timeout)
+}
+
+// AddWaitFlags adds flags for waiting the restore to complete
+func (o *CreateRestoreOptions) AddWaitFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.Wait, "wait", false, "Wait for the restore to complete")
+	cmd.Flags().DurationVar(&o.Timeout, "timeout", 30*time.Minute, "Time to wait for the restore to complete, only valid if --wait is true, such as --timeout=10m")
+}
+
 func NewCreateRestoreCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
 	customOutPut := func(opt *action.CreateOptions) {
 		output := fmt.Sprintf("Cluster %s created", opt.Name)
@@ -606,12 +1410,15 @@ func NewCreateRestoreCmd(f cmdutil.Factory, streams genericiooptions.IOStreams)
 			util.CheckErr(o.Complete())
 			util.CheckErr(o.Validate())
 			util.CheckErr(o.Run())
+			util.CheckErr(o.WaitRestore())
 		},
 	}
 
 	cmd.Flags().StringVar(&o.RestoreSpec.BackupName, "backup", "", "Backup name")
 	cmd.Flags().StringVar(&o.RestoreSpec.RestorePointInTime, "restore-to-time", "", "point in time recovery(PITR)")
 	cmd.Flags().StringVar(&o.RestoreSpec.VolumeRestorePolicy, "volume-restore-policy", "Parallel", "the volume claim restore policy, supported values: [Serial, Parallel]")
+	o.AddWaitFlags(cmd)
+	util.RegisterBackupCompletionFunc(cmd, f)
 	return cmd
 }
 
@@ -642,8 +1449,8 @@ func PrintBackupPolicyList(o action.ListOptions) error {
 		backupPolicyNameMap[name] = true
 	}
 
-	// if format is JSON or YAML, use default printer to output the result.
-	if o.Format == printer.JSON || o.Format == printer.YAML {
+	// if format is JSON, YAML or template, use default printer to output the result.
+	if o.Format == printer.JSON || o.Format == printer.YAML || o.Format == printer.Template {
 		_, err := o.Run()
 		return err
 	}
@@ -668,7 +1475,7 @@ func PrintBackupPolicyList(o action.ListOptions) error {
 	}
 
 	tbl := printer.NewTablePrinter(o.Out)
-	tbl.SetHeader("NAME", "NAMESPACE", "DEFAULT", "CLUSTER", "CREATE-TIME", "STATUS")
+	tbl.SetHeader("NAME", "NAMESPACE", "DEFAULT", "CLUSTER", "BACKUP-METHODS", "CREATE-TIME", "STATUS")
 	for _, obj := range backupPolicyList.Items {
 		defaultPolicy, ok := obj.GetAnnotations()[dptypes.DefaultBackupPolicyAnnotationKey]
 		backupPolicy := &dpv1alpha1.BackupPolicy{}
@@ -681,9 +1488,13 @@ func PrintBackupPolicyList(o action.ListOptions) error {
 		if len(o.Names) > 0 && !backupPolicyNameMap[backupPolicy.Name] {
 			continue
 		}
+		var methods []string
+		for _, m := range backupPolicy.Spec.BackupMethods {
+			methods = append(methods, m.Name)
+		}
 		createTime := obj.GetCreationTimestamp()
 		tbl.AddRow(obj.GetName(), obj.GetNamespace(), defaultPolicy, obj.GetLabels()[constant.AppInstanceLabelKey],
-			util.TimeFormat(&createTime), backupPolicy.Status.Phase)
+			strings.Join(methods, ","), util.TimeFormat(&createTime), backupPolicy.Status.Phase)
 	}
 	tbl.Print()
 	return nil
@@ -1114,18 +1925,94 @@ func (o *DescribeBackupPolicyOptions) printBackupPolicyObj(obj *dpv1alpha1.Backu
 	realPrintPairStringToLine("Cluster", obj.Labels[constant.AppInstanceLabelKey])
 	realPrintPairStringToLine("Namespace", obj.Namespace)
 	realPrintPairStringToLine("Default", strconv.FormatBool(obj.Annotations[dptypes.DefaultBackupPolicyAnnotationKey] == "true"))
-	if obj.Spec.BackupRepoName != nil {
+	realPrintPairStringToLine("Path Prefix", obj.Spec.PathPrefix)
+	if obj.Spec.BackoffLimit != nil {
+		realPrintPairStringToLine("Backoff Limit", strconv.Itoa(int(*obj.Spec.BackoffLimit)))
+	}
+
+	// the backup repo determines where the backup data will be stored
+	repo, err := o.getBackupRepo(obj.Spec.BackupRepoName)
+	if err != nil {
+		return err
+	}
+	switch {
+	case repo != nil && obj.Spec.BackupRepoName == nil:
+		realPrintPairStringToLine("Backup Repo Name", repo.Name+" (default)")
+	case obj.Spec.BackupRepoName != nil:
 		realPrintPairStringToLine("Backup Repo Name", *obj.Spec.BackupRepoName)
 	}
+	if repo != nil {
+		realPrintPairStringToLine("Storage Provider", repo.Spec.StorageProviderRef)
+	}
 
 	printer.PrintLine("\nBackup Methods:")
 	p := printer.NewTablePrinter(o.Out)
-	p.SetHeader("Name", "ActionSet", "snapshot-volumes")
+	p.SetHeader("Name", "ActionSet", "snapshot-volumes", "target-volumes")
 	for _, v := range obj.Spec.BackupMethods {
-		p.AddRow(v.Name, v.ActionSetName, strconv.FormatBool(*v.SnapshotVolumes))
+		var targetVolumes []string
+		if v.TargetVolumes != nil {
+			targetVolumes = append(targetVolumes, v.TargetVolumes.Volumes...)
+			for _, m := range v.TargetVolumes.VolumeMounts {
+				targetVolumes = append(targetVolumes, fmt.Sprintf("%s:%s", m.Name, m.MountPath))
+			}
+		}
+		p.AddRow(v.Name, v.ActionSetName, strconv.FormatBool(boolptr.IsSetToTrue(v.SnapshotVolumes)), strings.Join(targetVolumes, ","))
 	}
 	p.Print()
 
+	return o.printBackupSchedules(obj)
+}
+
+// getBackupRepo gets the backup repo by name, or the default backup repo if the name is not specified.
+func (o *DescribeBackupPolicyOptions) getBackupRepo(name *string) (*dpv1alpha1.BackupRepo, error) {
+	repo := &dpv1alpha1.BackupRepo{}
+	if name != nil {
+		if err := util.GetK8SClientObject(o.dynamic, repo, types.BackupRepoGVR(), "", *name); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return repo, nil
+	}
+	repos, err := o.dynamic.Resource(types.BackupRepoGVR()).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range repos.Items {
+		if item.GetAnnotations()[dptypes.DefaultBackupRepoAnnotationKey] != "true" {
+			continue
+		}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, repo); err != nil {
+			return nil, err
+		}
+		return repo, nil
+	}
+	return nil, nil
+}
+
+// printBackupSchedules prints the schedules and retention periods of the backup schedules using the backup policy.
+func (o *DescribeBackupPolicyOptions) printBackupSchedules(obj *dpv1alpha1.BackupPolicy) error {
+	scheduleList, err := o.dynamic.Resource(types.BackupScheduleGVR()).Namespace(obj.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	printer.PrintLine("\nBackup Schedules:")
+	p := printer.NewTablePrinter(o.Out)
+	p.SetHeader("Name", "Method", "Enabled", "cron-expression", "retention-period")
+	for _, item := range scheduleList.Items {
+		schedule := &dpv1alpha1.BackupSchedule{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, schedule); err != nil {
+			return err
+		}
+		if schedule.Spec.BackupPolicyName != obj.Name {
+			continue
+		}
+		for _, v := range schedule.Spec.Schedules {
+			p.AddRow(schedule.Name, v.BackupMethod, strconv.FormatBool(boolptr.IsSetToTrue(v.Enabled)), v.CronExpression, v.RetentionPeriod.String())
+		}
+	}
+	p.Print()
 	return nil
 }
 
@@ -1162,6 +2049,10 @@ func (o *DescribeBackupOptions) Complete(args []string) error {
 
 	o.names = args
 
+	if o.MaxRetries < 0 {
+		return fmt.Errorf("--max-retries must be greater than or equal to 0")
+	}
+
 	if o.client, err = o.Factory.KubernetesClientSet(); err != nil {
 		return err
 	}
@@ -1179,7 +2070,9 @@ func (o *DescribeBackupOptions) Complete(args []string) error {
 func (o *DescribeBackupOptions) Run() error {
 	for _, name := range o.names {
 		backupObj := &dpv1alpha1.Backup{}
-		if err := util.GetK8SClientObject(o.dynamic, backupObj, o.Gvr, o.namespace, name); err != nil {
+		if err := util.RetryOnTransientError(o.MaxRetries, func() error {
+			return util.GetK8SClientObject(o.dynamic, backupObj, o.Gvr, o.namespace, name)
+		}); err != nil {
 			return err
 		}
 		if err := o.printBackupObj(backupObj); err != nil {
@@ -1199,6 +2092,9 @@ func (o *DescribeBackupOptions) printBackupObj(obj *dpv1alpha1.Backup) error {
 	printer.PrintLine("\nSpec:")
 	realPrintPairStringToLine("Method", obj.Spec.BackupMethod)
 	realPrintPairStringToLine("Policy Name", obj.Spec.BackupPolicyName)
+	realPrintPairStringToLine("Deletion Policy", string(obj.Spec.DeletionPolicy))
+	realPrintPairStringToLine("Retention Period", string(obj.Spec.RetentionPeriod))
+	realPrintPairStringToLine("Parent Backup", obj.Spec.ParentBackupName)
 
 	printer.PrintLine("\nStatus:")
 	realPrintPairStringToLine("Phase", string(obj.Status.Phase))
@@ -1239,8 +2135,12 @@ func (o *DescribeBackupOptions) printBackupObj(obj *dpv1alpha1.Backup) error {
 	}
 
 	// get all events about backup
-	events, err := o.client.CoreV1().Events(o.namespace).Search(scheme.Scheme, obj)
-	if err != nil {
+	var events *corev1.EventList
+	if err := util.RetryOnTransientError(o.MaxRetries, func() error {
+		var err error
+		events, err = o.client.CoreV1().Events(o.namespace).Search(scheme.Scheme, obj)
+		return err
+	}); err != nil {
 		return err
 	}
 
@@ -1267,8 +2167,12 @@ func (o *DescribeBackupOptions) enhancePrintFailureReason(backupName, failureRea
 	labels := fmt.Sprintf("%s=%s",
 		dptypes.BackupNameLabelKey, backupName,
 	)
-	jobList, err := o.client.BatchV1().Jobs("").List(ctx, metav1.ListOptions{LabelSelector: labels})
-	if err != nil {
+	var jobList *batchv1.JobList
+	if err := util.RetryOnTransientError(o.MaxRetries, func() error {
+		var err error
+		jobList, err = o.client.BatchV1().Jobs("").List(ctx, metav1.ListOptions{LabelSelector: labels})
+		return err
+	}); err != nil {
 		return err
 	}
 	var failedJob *batchv1.Job
@@ -1282,8 +2186,12 @@ func (o *DescribeBackupOptions) enhancePrintFailureReason(backupName, failureRea
 		podLabels := fmt.Sprintf("%s=%s",
 			"controller-uid", failedJob.UID,
 		)
-		podList, err := o.client.CoreV1().Pods(failedJob.Namespace).List(ctx, metav1.ListOptions{LabelSelector: podLabels})
-		if err != nil {
+		var podList *corev1.PodList
+		if err := util.RetryOnTransientError(o.MaxRetries, func() error {
+			var err error
+			podList, err = o.client.CoreV1().Pods(failedJob.Namespace).List(ctx, metav1.ListOptions{LabelSelector: podLabels})
+			return err
+		}); err != nil {
 			return err
 		}
 		if len(podList.Items) > 0 {
@@ -1291,8 +2199,12 @@ func (o *DescribeBackupOptions) enhancePrintFailureReason(backupName, failureRea
 			req := o.client.CoreV1().
 				Pods(podList.Items[0].Namespace).
 				GetLogs(podList.Items[0].Name, &corev1.PodLogOptions{TailLines: &tailLines})
-			data, err := req.DoRaw(ctx)
-			if err != nil {
+			var data []byte
+			if err := util.RetryOnTransientError(o.MaxRetries, func() error {
+				var err error
+				data, err = req.DoRaw(ctx)
+				return err
+			}); err != nil {
 				return err
 			}
 			failureReason = fmt.Sprintf("%s\n pod %s error logs:\n%s",
@@ -24,23 +24,35 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/fatih/color"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 	"k8s.io/cli-runtime/pkg/resource"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	clientfake "k8s.io/client-go/rest/fake"
+	clienttesting "k8s.io/client-go/testing"
 	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
+	kubectlscheme "k8s.io/kubectl/pkg/scheme"
 
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
 	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
@@ -48,6 +60,7 @@ import (
 	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
 
 	"github.com/apecloud/kbcli/pkg/action"
+	"github.com/apecloud/kbcli/pkg/printer"
 	"github.com/apecloud/kbcli/pkg/testing"
 	"github.com/apecloud/kbcli/pkg/types"
 	"github.com/apecloud/kbcli/pkg/util"
@@ -126,6 +139,8 @@ var _ = Describe("DataProtection", func() {
 			cmd.Run(cmd, nil)
 			Expect(out.String()).Should(ContainSubstring(defaultBackupPolicy.Name))
 			Expect(out.String()).Should(ContainSubstring("true"))
+			Expect(out.String()).Should(ContainSubstring("BACKUP-METHODS"))
+			Expect(out.String()).Should(ContainSubstring(testing.BackupMethodName))
 			Expect(len(strings.Split(strings.Trim(out.String(), "\n"), "\n"))).Should(Equal(3))
 
 			By("test list all namespace")
@@ -185,6 +200,129 @@ var _ = Describe("DataProtection", func() {
 			Expect(o.runEditBackupPolicy()).Should(Succeed())
 		})
 
+		It("list-backup-schedule", func() {
+			initClient(testing.FakeBackupPolicy(policyName, testing.ClusterName), testing.FakeBackupSchedule("schedule", policyName))
+			o := action.NewListOptions(tf, streams, types.BackupScheduleGVR())
+			Expect(o.Complete()).Should(Succeed())
+			Expect(PrintBackupScheduleList(*o)).Should(Succeed())
+			Expect(out.String()).Should(ContainSubstring("schedule"))
+			Expect(out.String()).Should(ContainSubstring("0 0 * * *"))
+			Expect(out.String()).Should(ContainSubstring(string(dpv1alpha1.BackupSchedulePhaseAvailable)))
+
+			By("build the next run and status of each schedule")
+			schedule := testing.FakeBackupSchedule("schedule", policyName)
+			schedule.Spec.Schedules = append(schedule.Spec.Schedules, dpv1alpha1.SchedulePolicy{
+				BackupMethod:   "disabled-method",
+				CronExpression: "0 18 * * *",
+			})
+			infos := buildBackupScheduleInfo(schedule, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+			Expect(infos).Should(HaveLen(2))
+			Expect(infos[0].Cluster).Should(Equal(testing.ClusterName))
+			Expect(infos[0].NextRun).Should(Equal(util.TimeTimeFormat(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))))
+			Expect(infos[1].NextRun).Should(Equal("-"))
+			Expect(infos[1].Status).Should(Equal("Disabled"))
+		})
+
+		It("create-backup-schedule", func() {
+			initClient(testing.FakeBackupPolicy(policyName, testing.ClusterName))
+			o := &CreateBackupScheduleOptions{
+				Factory:        tf,
+				IOStreams:      streams,
+				ClusterName:    testing.ClusterName,
+				BackupMethod:   testing.BackupMethodName,
+				CronExpression: "0 18 * * *",
+			}
+			Expect(o.Complete()).Should(Succeed())
+			Expect(o.BackupPolicyName).Should(Equal(policyName))
+			Expect(o.Name).Should(Equal(fmt.Sprintf("%s-%s-schedule", testing.ClusterName, testing.BackupMethodName)))
+			Expect(o.Validate()).Should(Succeed())
+
+			By("validate the flags")
+			o.CronExpression = "invalid"
+			Expect(o.Validate()).Should(MatchError(ContainSubstring("invalid cron expression")))
+			o.CronExpression = "0 18 * * *"
+			o.RetentionPeriod = "7x"
+			Expect(o.Validate()).Should(MatchError(ContainSubstring("invalid retention period")))
+			o.RetentionPeriod = "7d"
+			o.BackupMethod = "unknown"
+			Expect(o.Validate()).Should(MatchError(ContainSubstring("is not found in backup policy")))
+			o.BackupMethod = testing.BackupMethodName
+
+			By("create the backup schedule")
+			Expect(o.Run()).Should(Succeed())
+			schedule := &dpv1alpha1.BackupSchedule{}
+			Expect(util.GetK8SClientObject(tf.FakeDynamicClient, schedule, types.BackupScheduleGVR(), testing.Namespace, o.Name)).Should(Succeed())
+			Expect(schedule.Spec.BackupPolicyName).Should(Equal(policyName))
+			Expect(schedule.Spec.Schedules[0].RetentionPeriod).Should(Equal(dpv1alpha1.RetentionPeriod("7d")))
+		})
+
+		It("enable and disable backup schedule", func() {
+			schedule := testing.FakeBackupSchedule("schedule", policyName)
+			initClient(schedule)
+			o := &ToggleBackupScheduleOptions{Name: "schedule", Factory: tf, IOStreams: streams}
+			Expect(o.Complete()).Should(Succeed())
+			Expect(o.Validate()).Should(Succeed())
+
+			By("validate the schedule and method exist")
+			o.BackupMethod = "unknown"
+			Expect(o.Validate()).Should(MatchError(ContainSubstring("is not found in backup schedule")))
+			o.BackupMethod = ""
+			o.Name = "unknown"
+			Expect(o.Validate()).Should(HaveOccurred())
+			o.Name = "schedule"
+
+			By("disable the schedule")
+			Expect(o.Run()).Should(Succeed())
+			Expect(out.String()).Should(ContainSubstring("Disabled"))
+			Expect(util.GetK8SClientObject(tf.FakeDynamicClient, schedule, types.BackupScheduleGVR(), testing.Namespace, o.Name)).Should(Succeed())
+			Expect(*schedule.Spec.Schedules[0].Enabled).Should(BeFalse())
+
+			By("enable the schedule")
+			o.Enabled = true
+			o.BackupMethod = testing.BackupMethodName
+			Expect(o.Run()).Should(Succeed())
+			Expect(util.GetK8SClientObject(tf.FakeDynamicClient, schedule, types.BackupScheduleGVR(), testing.Namespace, o.Name)).Should(Succeed())
+			Expect(*schedule.Spec.Schedules[0].Enabled).Should(BeTrue())
+		})
+
+		It("delete backup schedule with cascade", func() {
+			schedule := testing.FakeBackupSchedule("schedule", policyName)
+			scheduledBackup := testing.FakeBackup("scheduled-backup")
+			scheduledBackup.Labels = map[string]string{dptypes.BackupScheduleLabelKey: schedule.Name}
+			initClient(schedule, scheduledBackup, testing.FakeBackup("manual-backup"))
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(schedule)
+			Expect(err).Should(Succeed())
+			scheduleObj := &unstructured.Unstructured{Object: obj}
+
+			By("do nothing without cascade")
+			o := NewDeleteBackupScheduleOptions(tf, streams)
+			o.AutoApprove = true
+			Expect(o.PreDeleteHook(o.DeleteOptions, scheduleObj)).Should(Succeed())
+			Expect(o.PostDeleteHook(o.DeleteOptions, scheduleObj)).Should(Succeed())
+			Expect(out.String()).Should(BeEmpty())
+
+			By("keep the backups if the deletion of them is declined")
+			o.Cascade = true
+			o.AutoApprove = false
+			o.In = bytes.NewBufferString("no\n")
+			Expect(o.PreDeleteHook(o.DeleteOptions, scheduleObj)).Should(Succeed())
+			Expect(out.String()).Should(ContainSubstring("Skip deleting the backups of backup schedule schedule"))
+			Expect(o.PostDeleteHook(o.DeleteOptions, scheduleObj)).Should(Succeed())
+			_, err = tf.FakeDynamicClient.Resource(types.BackupGVR()).Namespace(testing.Namespace).Get(context.TODO(), scheduledBackup.Name, metav1.GetOptions{})
+			Expect(err).Should(Succeed())
+
+			By("delete the backups created by the schedule")
+			o.AutoApprove = true
+			Expect(o.PreDeleteHook(o.DeleteOptions, scheduleObj)).Should(Succeed())
+			Expect(out.String()).Should(ContainSubstring("Found 1 backups"))
+			Expect(o.PostDeleteHook(o.DeleteOptions, scheduleObj)).Should(Succeed())
+			Expect(out.String()).Should(ContainSubstring("1 backups of backup schedule schedule deleted"))
+			_, err = tf.FakeDynamicClient.Resource(types.BackupGVR()).Namespace(testing.Namespace).Get(context.TODO(), scheduledBackup.Name, metav1.GetOptions{})
+			Expect(apierrors.IsNotFound(err)).Should(BeTrue())
+			_, err = tf.FakeDynamicClient.Resource(types.BackupGVR()).Namespace(testing.Namespace).Get(context.TODO(), "manual-backup", metav1.GetOptions{})
+			Expect(err).Should(Succeed())
+		})
+
 		It("validate create backup", func() {
 			By("without cluster name")
 			o := &CreateBackupOptions{
@@ -196,9 +334,12 @@ var _ = Describe("DataProtection", func() {
 			}
 			Expect(o.Validate()).To(MatchError("missing cluster name"))
 
-			By("test without default backupPolicy")
+			By("test with nonexistent cluster")
 			o.Name = testing.ClusterName
 			o.Namespace = testing.Namespace
+			Expect(o.Validate()).Should(MatchError(fmt.Errorf(`cluster "%s" not found in namespace "%s"`, testing.ClusterName, testing.Namespace)))
+
+			By("test without default backupPolicy")
 			initClient()
 			o.Dynamic = tf.FakeDynamicClient
 			Expect(o.Validate()).Should(MatchError(fmt.Errorf(`not found any backup policy for cluster "%s"`, testing.ClusterName)))
@@ -267,6 +408,22 @@ var _ = Describe("DataProtection", func() {
 			err := o.Validate()
 			Expect(err).Should(Succeed())
 		})
+
+		It("run backup command with dry-run", func() {
+			defaultRepo := testing.FakeBackupRepo("default-repo", true)
+			initClient(testing.FakeBackupPolicy(policyName, testing.ClusterName), defaultRepo)
+			cmd := NewCreateBackupCmd(tf, streams)
+			Expect(cmd.Flags().Set("method", testing.BackupMethodName)).Should(Succeed())
+			Expect(cmd.Flags().Set("name", "dry-run-backup")).Should(Succeed())
+			Expect(cmd.Flags().Set("dry-run", "client")).Should(Succeed())
+			cmd.Run(cmd, []string{testing.ClusterName})
+			Expect(out.String()).Should(ContainSubstring("kind: OpsRequest"))
+			Expect(out.String()).Should(ContainSubstring("backupName: dry-run-backup"))
+
+			opsList, err := tf.FakeDynamicClient.Resource(types.OpsGVR()).Namespace(testing.Namespace).List(context.TODO(), metav1.ListOptions{})
+			Expect(err).Should(Succeed())
+			Expect(opsList.Items).Should(BeEmpty())
+		})
 	})
 
 	It("delete-backup", func() {
@@ -292,6 +449,25 @@ var _ = Describe("DataProtection", func() {
 		o.LabelSelector = customLabel
 		Expect(completeForDeleteBackup(o, args)).Should(Succeed())
 		Expect(o.LabelSelector == customLabel+","+clusterLabel).Should(BeTrue())
+
+		By("test delete running backup without force")
+		backup := testing.FakeBackup("test1")
+		backup.Status.Phase = dpv1alpha1.BackupPhaseRunning
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(backup)
+		Expect(err).Should(Succeed())
+		o.Force = false
+		Expect(PreDeleteBackup(o, &unstructured.Unstructured{Object: obj})).Should(MatchError(`backup "test1" is Running, you can specify "--force" to delete it`))
+
+		By("test delete running backup with force")
+		o.Force = true
+		Expect(PreDeleteBackup(o, &unstructured.Unstructured{Object: obj})).Should(Succeed())
+
+		By("test delete completed backup")
+		backup.Status.Phase = dpv1alpha1.BackupPhaseCompleted
+		obj, err = runtime.DefaultUnstructuredConverter.ToUnstructured(backup)
+		Expect(err).Should(Succeed())
+		o.Force = false
+		Expect(PreDeleteBackup(o, &unstructured.Unstructured{Object: obj})).Should(Succeed())
 	})
 
 	It("list-backup", func() {
@@ -321,6 +497,569 @@ var _ = Describe("DataProtection", func() {
 		o.AllNamespaces = true
 		Expect(PrintBackupList(o)).Should(Succeed())
 		Expect(len(strings.Split(strings.Trim(o.Out.(*bytes.Buffer).String(), "\n"), "\n"))).Should(Equal(3))
+
+		By("test list-backup with wide output")
+		o.Out.(*bytes.Buffer).Reset()
+		o.Format = printer.Wide
+		Expect(PrintBackupList(o)).Should(Succeed())
+		Expect(o.Out.(*bytes.Buffer).String()).Should(ContainSubstring("LABELS"))
+		Expect(o.Out.(*bytes.Buffer).String()).Should(ContainSubstring(constant.AppInstanceLabelKey + "=apecloud-mysql"))
+
+		By("test list-backup with table output")
+		o.Out.(*bytes.Buffer).Reset()
+		o.Format = printer.Table
+		Expect(PrintBackupList(o)).Should(Succeed())
+		Expect(o.Out.(*bytes.Buffer).String()).ShouldNot(ContainSubstring("LABELS"))
+
+		By("test list-backup with invalid sort key")
+		o.OrderBy = "unknown"
+		Expect(PrintBackupList(o)).Should(MatchError(ContainSubstring(`invalid sort key "unknown"`)))
+
+		By("test list-backup with unsupported field selector")
+		o.OrderBy = ""
+		o.FieldSelector = "status.phase=Completed"
+		fakeDynamic := testing.FakeDynamicClient(backup1, backup2)
+		fakeDynamic.PrependReactor("list", "backups", func(action clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewBadRequest(`field label not supported: status.phase`)
+		})
+		tf.FakeDynamicClient = fakeDynamic
+		Expect(PrintBackupList(o)).Should(MatchError(ContainSubstring(`invalid field selector "status.phase=Completed"`)))
+	})
+
+	It("list backups with cancelled context", func() {
+		tf.FakeDynamicClient = testing.FakeDynamicClient(testing.FakeBackup("test1"))
+		o := ListBackupOptions{ListOptions: action.NewListOptions(tf, streams, types.BackupGVR())}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		o.Ctx = ctx
+		Expect(PrintBackupList(o)).Should(MatchError(context.Canceled))
+		Expect(o.Out.(*bytes.Buffer).String()).ShouldNot(ContainSubstring("test1"))
+
+		By("the requests of json output are sent with the cancelled context")
+		// the REST mapper of the test factory is built from the scheme of kubectl
+		Expect(dpv1alpha1.AddToScheme(kubectlscheme.Scheme)).Should(Succeed())
+		f := cmdtesting.NewTestFactory().WithNamespace(testing.Namespace).WithDiscoveryClient(testing.FakeDiscoveryClient())
+		defer f.Cleanup()
+		var requestCtxErr error
+		f.ClientConfigVal = &rest.Config{
+			Host: "http://localhost",
+			Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				requestCtxErr = req.Context().Err()
+				return nil, requestCtxErr
+			}),
+		}
+		o = ListBackupOptions{ListOptions: action.NewListOptions(f, streams, types.BackupGVR())}
+		o.Ctx = ctx
+		o.Format = printer.JSON
+		Expect(PrintBackupList(o)).Should(MatchError(ContainSubstring(context.Canceled.Error())))
+		Expect(requestCtxErr).Should(MatchError(context.Canceled))
+	})
+
+	It("wait for the backup to complete", func() {
+		toUnstructured := func(backup *dpv1alpha1.Backup) *unstructured.Unstructured {
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(backup)
+			Expect(err).Should(Succeed())
+			return &unstructured.Unstructured{Object: obj}
+		}
+		waitBackup := func(phases ...dpv1alpha1.BackupPhase) (string, error) {
+			backup := testing.FakeBackup("test1")
+			fakeWatcher := watch.NewFake()
+			fakeDynamic := testing.FakeDynamicClient()
+			fakeDynamic.PrependWatchReactor("backups", clienttesting.DefaultWatchReactor(fakeWatcher, nil))
+			out := &bytes.Buffer{}
+			errCh := make(chan error)
+			go func() {
+				_, err := waitBackupCompleted(context.Background(), fakeDynamic, types.BackupGVR(), out, testing.Namespace, backup.Name, "")
+				errCh <- err
+			}()
+			backup.Status.Phase = ""
+			fakeWatcher.Add(toUnstructured(backup))
+			for _, phase := range phases {
+				backup.Status.Phase = phase
+				fakeWatcher.Modify(toUnstructured(backup))
+			}
+			var err error
+			Eventually(errCh).Should(Receive(&err))
+			return out.String(), err
+		}
+
+		By("the backup completes")
+		output, err := waitBackup(dpv1alpha1.BackupPhaseNew, dpv1alpha1.BackupPhaseRunning, dpv1alpha1.BackupPhaseRunning, dpv1alpha1.BackupPhaseCompleted)
+		Expect(err).Should(Succeed())
+		Expect(strings.Count(output, "\n")).Should(Equal(3))
+		Expect(output).Should(ContainSubstring("Backup test1 is Running"))
+		Expect(output).Should(ContainSubstring("Backup test1 is Completed"))
+
+		By("the backup fails")
+		output, err = waitBackup(dpv1alpha1.BackupPhaseRunning, dpv1alpha1.BackupPhaseFailed)
+		Expect(err).Should(MatchError(ContainSubstring("backup test1 failed")))
+		Expect(output).Should(ContainSubstring("Backup test1 is Failed"))
+
+		By("the backup does not complete before timeout")
+		fakeDynamic := testing.FakeDynamicClient()
+		fakeDynamic.PrependWatchReactor("backups", clienttesting.DefaultWatchReactor(watch.NewFake(), nil))
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		_, err = waitBackupCompleted(ctx, fakeDynamic, types.BackupGVR(), &bytes.Buffer{}, testing.Namespace, "test1", "")
+		Expect(err).Should(MatchError(ContainSubstring("timed out waiting for backup")))
+
+		By("the watch is closed by the server")
+		watchers := []*watch.FakeWatcher{watch.NewFake(), watch.NewFake()}
+		var watchCount atomic.Int32
+		fakeDynamic = testing.FakeDynamicClient()
+		fakeDynamic.PrependWatchReactor("backups", func(clienttesting.Action) (bool, watch.Interface, error) {
+			if i := int(watchCount.Add(1)) - 1; i < len(watchers) {
+				return true, watchers[i], nil
+			}
+			return true, watch.NewFake(), nil
+		})
+		errCh := make(chan error)
+		go func() {
+			_, err := waitBackupCompleted(context.Background(), fakeDynamic, types.BackupGVR(), &bytes.Buffer{}, testing.Namespace, "test1", "")
+			errCh <- err
+		}()
+		backup := testing.FakeBackup("test1")
+		backup.Status.Phase = dpv1alpha1.BackupPhaseRunning
+		watchers[0].Add(toUnstructured(backup))
+		watchers[0].Stop()
+		backup.Status.Phase = dpv1alpha1.BackupPhaseCompleted
+		watchers[1].Modify(toUnstructured(backup))
+		Eventually(errCh, 10*time.Second).Should(Receive(BeNil()))
+
+		By("the backup OpsRequest fails before the backup is created")
+		ops := &appsv1alpha1.OpsRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test1", Namespace: testing.Namespace},
+			Spec:       appsv1alpha1.OpsRequestSpec{ClusterName: testing.ClusterName, Type: appsv1alpha1.BackupType},
+			Status:     appsv1alpha1.OpsRequestStatus{Phase: appsv1alpha1.OpsFailedPhase},
+		}
+		fakeDynamic = testing.FakeDynamicClient(ops)
+		fakeDynamic.PrependWatchReactor("backups", clienttesting.DefaultWatchReactor(watch.NewFake(), nil))
+		_, err = waitBackupCompleted(context.Background(), fakeDynamic, types.BackupGVR(), &bytes.Buffer{}, testing.Namespace, "test1", ops.Name)
+		Expect(err).Should(MatchError(ContainSubstring("OpsRequest test1 of backup is Failed")))
+	})
+
+	It("push the backup metrics", func() {
+		var (
+			method string
+			path   string
+			body   []byte
+		)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method, path = r.Method, r.URL.Path
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		backup := testing.FakeBackup("test1")
+		backup.Spec.BackupMethod = testing.BackupMethodName
+		backup.Status.Phase = dpv1alpha1.BackupPhaseCompleted
+		backup.Status.Duration = &metav1.Duration{Duration: time.Minute}
+		Expect(pushBackupMetrics(server.URL, testing.ClusterName, backup)).Should(Succeed())
+		Expect(method).Should(Equal(http.MethodPut))
+		Expect(path).Should(HavePrefix("/metrics/job/kbcli_backup/instance@base64/"))
+		for _, s := range []string{"kbcli_backup_duration_seconds", testing.ClusterName, testing.BackupMethodName, string(dpv1alpha1.BackupPhaseCompleted)} {
+			Expect(string(body)).Should(ContainSubstring(s))
+		}
+
+		By("the push gateway is unavailable")
+		server.Close()
+		Expect(pushBackupMetrics(server.URL, testing.ClusterName, backup)).Should(HaveOccurred())
+	})
+
+	It("watch backups", func() {
+		o := ListBackupOptions{ListOptions: action.NewListOptions(tf, streams, types.BackupGVR())}
+		o.Watch = true
+		o.Format = printer.JSON
+		Expect(PrintBackupList(o)).Should(MatchError(ContainSubstring("--watch is only supported")))
+
+		By("watch the changes of backups")
+		o.Format = printer.Table
+		backup1 := testing.FakeBackup("test1")
+		backup2 := testing.FakeBackup("test2")
+		fakeWatcher := watch.NewFake()
+		fakeDynamic := testing.FakeDynamicClient(backup1)
+		fakeDynamic.PrependWatchReactor("backups", clienttesting.DefaultWatchReactor(fakeWatcher, nil))
+		client := fakeDynamic.Resource(types.BackupGVR()).Namespace(testing.Namespace)
+		backupList, err := client.List(context.TODO(), metav1.ListOptions{})
+		Expect(err).Should(Succeed())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error)
+		go func() {
+			errCh <- watchBackups(ctx, o, client, backupList)
+		}()
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(backup2)
+		Expect(err).Should(Succeed())
+		fakeWatcher.Add(&unstructured.Unstructured{Object: obj})
+		fakeWatcher.Delete(&unstructured.Unstructured{Object: obj})
+		cancel()
+		Expect(<-errCh).Should(Succeed())
+		output := o.Out.(*bytes.Buffer).String()
+		Expect(strings.Count(output, "SOURCE-CLUSTER")).Should(Equal(2))
+		Expect(output).Should(ContainSubstring("test2"))
+	})
+
+	It("sort backups", func() {
+		now := time.Now()
+		newBackup := func(name string, phase dpv1alpha1.BackupPhase, created time.Time) *dpv1alpha1.Backup {
+			backup := testing.FakeBackup(name)
+			backup.CreationTimestamp = metav1.NewTime(created)
+			backup.Status.Phase = phase
+			backup.Status.StartTimestamp = &backup.CreationTimestamp
+			return backup
+		}
+		names := func(backups []*dpv1alpha1.Backup) []string {
+			var res []string
+			for _, b := range backups {
+				res = append(res, b.Name)
+			}
+			return res
+		}
+		backups := []*dpv1alpha1.Backup{
+			newBackup("b", dpv1alpha1.BackupPhaseCompleted, now.Add(-time.Minute)),
+			newBackup("c", dpv1alpha1.BackupPhaseFailed, now.Add(-time.Hour)),
+			newBackup("a", dpv1alpha1.BackupPhaseCompleted, now),
+		}
+
+		By("sort by creation time in default")
+		Expect(sortBackups(backups, "", false)).Should(Succeed())
+		Expect(names(backups)).Should(Equal([]string{"c", "b", "a"}))
+
+		By("sort by name")
+		Expect(sortBackups(backups, backupOrderByName, false)).Should(Succeed())
+		Expect(names(backups)).Should(Equal([]string{"a", "b", "c"}))
+
+		By("sort by phase, the backups with the same phase keep the creation order")
+		Expect(sortBackups(backups, backupOrderByPhase, false)).Should(Succeed())
+		Expect(names(backups)).Should(Equal([]string{"b", "a", "c"}))
+
+		By("sort by start time in reverse order")
+		Expect(sortBackups(backups, backupOrderByStartTime, true)).Should(Succeed())
+		Expect(names(backups)).Should(Equal([]string{"a", "b", "c"}))
+
+		By("sort by completion time, the backups without completion time keep the creation order")
+		Expect(sortBackups(backups, backupOrderByCompletionTime, false)).Should(Succeed())
+		Expect(names(backups)).Should(Equal([]string{"c", "b", "a"}))
+
+		By("sort by size")
+		backups[0].Status.TotalSize = "2GiB"
+		backups[1].Status.TotalSize = "1073741824"
+		Expect(sortBackups(backups, backupOrderBySize, false)).Should(Succeed())
+		Expect(names(backups)).Should(Equal([]string{"a", "b", "c"}))
+	})
+
+	It("backup size", func() {
+		backup := testing.FakeBackup("test")
+		for _, c := range []struct {
+			totalSize string
+			bytes     int64
+			human     string
+		}{
+			{"", 0, ""},
+			{"1073741824", 1073741824, "1.0 GiB"},
+			{"1.5GiB", 1610612736, "1.5 GiB"},
+			{"123456", 123456, "121 KiB"},
+			{"unknown", 0, "unknown"},
+		} {
+			backup.Status.TotalSize = c.totalSize
+			bytes, human := backupSize(backup)
+			Expect(bytes).Should(Equal(c.bytes))
+			Expect(human).Should(Equal(c.human))
+		}
+	})
+
+	It("print backups with nil, partial and complete status", func() {
+		newObj := func(name string, status map[string]interface{}) unstructured.Unstructured {
+			obj := unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": types.BackupGVR().GroupVersion().String(),
+				"kind":       types.KindBackup,
+				"metadata": map[string]interface{}{
+					"name":              name,
+					"namespace":         testing.Namespace,
+					"creationTimestamp": "2024-01-01T00:00:00Z",
+				},
+				"spec": map[string]interface{}{"backupMethod": testing.BackupMethodName},
+			}}
+			if status != nil {
+				obj.Object["status"] = status
+			}
+			return obj
+		}
+		o := ListBackupOptions{ListOptions: action.NewListOptions(tf, streams, types.BackupGVR())}
+
+		By("the status is nil")
+		Expect(printBackupTable(o, []unstructured.Unstructured{newObj("nil-status", nil)})).Should(Succeed())
+		Expect(o.Out.(*bytes.Buffer).String()).Should(ContainSubstring("nil-status"))
+
+		By("only the phase is set")
+		o.Out.(*bytes.Buffer).Reset()
+		Expect(printBackupTable(o, []unstructured.Unstructured{newObj("phase-only", map[string]interface{}{
+			"phase": string(dpv1alpha1.BackupPhaseRunning),
+		})})).Should(Succeed())
+		Expect(o.Out.(*bytes.Buffer).String()).Should(ContainSubstring(string(dpv1alpha1.BackupPhaseRunning)))
+
+		By("all the fields are set")
+		o.Out.(*bytes.Buffer).Reset()
+		Expect(printBackupTable(o, []unstructured.Unstructured{newObj("complete", map[string]interface{}{
+			"phase":               string(dpv1alpha1.BackupPhaseCompleted),
+			"totalSize":           "1073741824",
+			"startTimestamp":      "2024-01-01T00:00:00Z",
+			"completionTimestamp": "2024-01-01T00:01:00Z",
+			"expiration":          "2024-01-08T00:00:00Z",
+		})})).Should(Succeed())
+		output := o.Out.(*bytes.Buffer).String()
+		Expect(output).Should(ContainSubstring(string(dpv1alpha1.BackupPhaseCompleted)))
+		Expect(output).Should(ContainSubstring("1.0 GiB"))
+		Expect(output).Should(ContainSubstring("60s"))
+		Expect(output).Should(ContainSubstring("AGE"))
+
+		By("the fields have unexpected types")
+		for _, status := range []map[string]interface{}{
+			{"phase": int64(1)},
+			{"totalSize": int64(1024)},
+			{"completionTimestamp": true},
+		} {
+			Expect(printBackupTable(o, []unstructured.Unstructured{newObj("invalid", status)})).Should(HaveOccurred())
+		}
+	})
+
+	It("backup age", func() {
+		now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+		backup := &dpv1alpha1.Backup{}
+		Expect(backupAge(backup, now)).Should(BeEmpty())
+		backup.Status.StartTimestamp = &metav1.Time{Time: now.Add(-26 * time.Hour)}
+		Expect(backupAge(backup, now)).Should(Equal("26h"))
+
+		By("set the derived age of backup object")
+		obj := testing.FakeBackup("test1")
+		obj.Status.StartTimestamp = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		Expect(err).Should(Succeed())
+		uObj := &unstructured.Unstructured{Object: u}
+		Expect(setBackupAge(uObj)).Should(Succeed())
+		age, found, err := unstructured.NestedString(uObj.Object, "status", "age")
+		Expect(err).Should(Succeed())
+		Expect(found).Should(BeTrue())
+		Expect(age).Should(Equal("60m"))
+	})
+
+	It("backup expiring soon", func() {
+		now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+		backup := &dpv1alpha1.Backup{}
+		backup.Status.Phase = dpv1alpha1.BackupPhaseCompleted
+		Expect(backupExpiringSoon(backup, now, 24*time.Hour)).Should(BeFalse())
+
+		By("compute the expiration from the retention period")
+		backup.Spec.RetentionPeriod = "2d"
+		backup.Status.StartTimestamp = &metav1.Time{Time: now.Add(-30 * time.Hour)}
+		Expect(*backupExpiration(backup)).Should(Equal(now.Add(18 * time.Hour)))
+		Expect(backupExpiringSoon(backup, now, 24*time.Hour)).Should(BeTrue())
+		Expect(backupExpiringSoon(backup, now, 12*time.Hour)).Should(BeFalse())
+		Expect(backupExpiringSoon(backup, now, 0)).Should(BeFalse())
+
+		By("prefer the expiration in status")
+		backup.Status.Expiration = &metav1.Time{Time: now.Add(48 * time.Hour)}
+		Expect(backupExpiringSoon(backup, now, 24*time.Hour)).Should(BeFalse())
+		backup.Status.Expiration = &metav1.Time{Time: now.Add(-time.Hour)}
+		Expect(backupExpiringSoon(backup, now, 24*time.Hour)).Should(BeFalse())
+
+		By("only warn the completed backups")
+		backup.Status.Expiration = &metav1.Time{Time: now.Add(time.Hour)}
+		Expect(backupExpiringSoon(backup, now, 24*time.Hour)).Should(BeTrue())
+		backup.Status.Phase = dpv1alpha1.BackupPhaseFailed
+		Expect(backupExpiringSoon(backup, now, 24*time.Hour)).Should(BeFalse())
+
+		By("show the warning in the table")
+		out := &bytes.Buffer{}
+		o := ListBackupOptions{ListOptions: action.NewListOptions(tf, genericiooptions.IOStreams{Out: out, ErrOut: out}, types.BackupGVR()), WarnTTLHours: 24}
+		obj := testing.FakeBackup("test1")
+		obj.Status.Phase = dpv1alpha1.BackupPhaseCompleted
+		obj.Status.Expiration = &metav1.Time{Time: time.Now().Add(time.Hour)}
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		Expect(err).Should(Succeed())
+		Expect(printBackupTable(o, []unstructured.Unstructured{{Object: u}})).Should(Succeed())
+		Expect(out.String()).Should(ContainSubstring("EXPIRING SOON"))
+	})
+
+	It("list backups since", func() {
+		now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+		By("parse --since")
+		t, err := parseSince("", now)
+		Expect(err).Should(Succeed())
+		Expect(t.IsZero()).Should(BeTrue())
+		t, err = parseSince("24h", now)
+		Expect(err).Should(Succeed())
+		Expect(t).Should(Equal(now.Add(-24 * time.Hour)))
+		t, err = parseSince("2024-01-01T12:00:00Z", now)
+		Expect(err).Should(Succeed())
+		Expect(t).Should(Equal(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)))
+		_, err = parseSince("-1h", now)
+		Expect(err).Should(MatchError(ContainSubstring("must not be negative")))
+		_, err = parseSince("yesterday", now)
+		Expect(err).Should(MatchError(ContainSubstring(`invalid --since "yesterday"`)))
+
+		By("filter backups by start time")
+		newBackup := func(name string, start *time.Time) *dpv1alpha1.Backup {
+			backup := testing.FakeBackup(name)
+			backup.CreationTimestamp = metav1.NewTime(now.Add(-48 * time.Hour))
+			if start != nil {
+				backup.Status.StartTimestamp = &metav1.Time{Time: *start}
+			}
+			return backup
+		}
+		cutoff := now.Add(-24 * time.Hour)
+		before, after := cutoff.Add(-time.Second), cutoff.Add(time.Second)
+		tf.FakeDynamicClient = testing.FakeDynamicClient(newBackup("before", &before), newBackup("boundary", &cutoff),
+			newBackup("after", &after), newBackup("pending", nil))
+		o := ListBackupOptions{ListOptions: action.NewListOptions(tf, streams, types.BackupGVR())}
+		o.Since = cutoff.Format(time.RFC3339)
+		Expect(PrintBackupList(o)).Should(Succeed())
+		output := o.Out.(*bytes.Buffer).String()
+		Expect(output).Should(ContainSubstring("boundary"))
+		Expect(output).Should(ContainSubstring("after"))
+		Expect(output).ShouldNot(ContainSubstring("before"))
+		Expect(output).ShouldNot(ContainSubstring("pending"))
+
+		By("--since is not supported with json output")
+		o.Format = printer.JSON
+		Expect(PrintBackupList(o)).Should(MatchError(ContainSubstring("--since, --phase, --limit and --continue are only supported")))
+	})
+
+	It("list backups with table only flags", func() {
+		for _, args := range [][]string{{"--sort-by=name"}, {"--reverse"}, {"--warn-ttl-hours=48"}} {
+			o := ListBackupOptions{ListOptions: action.NewListOptions(tf, streams, types.BackupGVR())}
+			cmd := &cobra.Command{}
+			o.AddBackupFlags(cmd)
+			Expect(cmd.Flags().Parse(args)).Should(Succeed())
+			o.Format = printer.JSON
+			By(args[0] + " is not supported with json output")
+			Expect(PrintBackupList(o)).Should(MatchError(ContainSubstring("--sort-by, --reverse and --warn-ttl-hours are only supported")))
+		}
+	})
+
+	It("list backups with limit", func() {
+		o := ListBackupOptions{ListOptions: action.NewListOptions(tf, streams, types.BackupGVR())}
+		o.Limit = -1
+		Expect(PrintBackupList(o)).Should(MatchError(ContainSubstring("--limit must not be negative")))
+		o.Limit = 1
+		o.Watch = true
+		Expect(PrintBackupList(o)).Should(MatchError(ContainSubstring("--watch can not be used with --limit")))
+
+		By("print the continue token if there are more backups")
+		o.Watch = false
+		fakeDynamic := testing.FakeDynamicClient()
+		fakeDynamic.PrependReactor("list", "backups", func(action clienttesting.Action) (bool, runtime.Object, error) {
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(testing.FakeBackup("test1"))
+			if err != nil {
+				return true, nil, err
+			}
+			list := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{{Object: obj}}}
+			list.SetContinue("next-token")
+			return true, list, nil
+		})
+		tf.FakeDynamicClient = fakeDynamic
+		Expect(PrintBackupList(o)).Should(Succeed())
+		Expect(o.Out.(*bytes.Buffer).String()).Should(ContainSubstring(`--continue next-token`))
+
+		By("retry with a smaller page size if the API server rejects the limit")
+		calls := 0
+		fakeDynamic = testing.FakeDynamicClient(testing.FakeBackup("test1"))
+		fakeDynamic.PrependReactor("list", "backups", func(action clienttesting.Action) (bool, runtime.Object, error) {
+			calls++
+			if calls == 1 {
+				return true, nil, apierrors.NewInvalid(dpv1alpha1.SchemeGroupVersion.WithKind(types.KindBackup).GroupKind(), "",
+					field.ErrorList{field.Invalid(field.NewPath("limit"), 100, "the page size is too large")})
+			}
+			return false, nil, nil
+		})
+		tf.FakeDynamicClient = fakeDynamic
+		o.Limit = 100
+		Expect(PrintBackupList(o)).Should(Succeed())
+		Expect(calls).Should(Equal(2))
+		Expect(o.ErrOut.(*bytes.Buffer).String()).Should(ContainSubstring("retry with page size 50"))
+
+		By("do not retry if the page size is 1")
+		calls = 0
+		o.Limit = 1
+		Expect(PrintBackupList(o)).Should(HaveOccurred())
+		Expect(calls).Should(Equal(1))
+
+		By("do not retry if the request is rejected for other reasons")
+		fakeDynamic = testing.FakeDynamicClient(testing.FakeBackup("test1"))
+		fakeDynamic.PrependReactor("list", "backups", func(action clienttesting.Action) (bool, runtime.Object, error) {
+			calls++
+			// the message is not matched, the label value happens to contain "limit"
+			return true, nil, apierrors.NewBadRequest("unable to parse requirement: invalid label value: limit=")
+		})
+		tf.FakeDynamicClient = fakeDynamic
+		calls = 0
+		o.Limit = 100
+		Expect(PrintBackupList(o)).Should(HaveOccurred())
+		Expect(calls).Should(Equal(1))
+	})
+
+	It("list backups by phase", func() {
+		newBackup := func(name string, phase dpv1alpha1.BackupPhase) *dpv1alpha1.Backup {
+			backup := testing.FakeBackup(name)
+			backup.Status.Phase = phase
+			return backup
+		}
+		tf.FakeDynamicClient = testing.FakeDynamicClient(newBackup("running-backup", dpv1alpha1.BackupPhaseRunning),
+			newBackup("failed-backup", dpv1alpha1.BackupPhaseFailed), newBackup("completed-backup", dpv1alpha1.BackupPhaseCompleted))
+		o := ListBackupOptions{ListOptions: action.NewListOptions(tf, streams, types.BackupGVR())}
+		o.Phases = []string{"failed", "Running"}
+		Expect(PrintBackupList(o)).Should(Succeed())
+		output := o.Out.(*bytes.Buffer).String()
+		Expect(output).Should(ContainSubstring("running-backup"))
+		Expect(output).Should(ContainSubstring("failed-backup"))
+		Expect(output).ShouldNot(ContainSubstring("completed-backup"))
+		// the spinner is disabled if the output is not a terminal
+		Expect(output).ShouldNot(ContainSubstring("\033[?25h"))
+
+		By("invalid phase")
+		o.Phases = []string{"Unknown"}
+		Expect(PrintBackupList(o)).Should(MatchError(ContainSubstring(`invalid backup phase "Unknown"`)))
+	})
+
+	It("color backup phase", func() {
+		noColor := color.NoColor
+		defer func() { color.NoColor = noColor }()
+
+		color.NoColor = true
+		Expect(colorBackupPhase(dpv1alpha1.BackupPhaseCompleted)).Should(Equal(string(dpv1alpha1.BackupPhaseCompleted)))
+
+		color.NoColor = false
+		Expect(colorBackupPhase(dpv1alpha1.BackupPhaseCompleted)).Should(Equal(printer.BoldGreen(dpv1alpha1.BackupPhaseCompleted)))
+		Expect(colorBackupPhase(dpv1alpha1.BackupPhaseRunning)).Should(Equal(printer.BoldYellow(dpv1alpha1.BackupPhaseRunning)))
+		Expect(colorBackupPhase(dpv1alpha1.BackupPhaseFailed)).Should(Equal(printer.BoldRed(dpv1alpha1.BackupPhaseFailed)))
+		Expect(colorBackupPhase(dpv1alpha1.BackupPhaseDeleting)).Should(Equal(string(dpv1alpha1.BackupPhaseDeleting)))
+	})
+
+	It("backup duration", func() {
+		now := time.Now()
+		start := metav1.NewTime(now.Add(-time.Hour))
+		backup := testing.FakeBackup("test1")
+		Expect(backupDuration(backup, now)).Should(BeNil())
+
+		By("use status.duration if it is set")
+		backup.Status.Duration = &metav1.Duration{Duration: time.Minute}
+		Expect(*backupDuration(backup, now)).Should(Equal(time.Minute))
+
+		By("compute duration from start and completion timestamps")
+		backup.Status.Duration = nil
+		backup.Status.StartTimestamp = &start
+		backup.Status.CompletionTimestamp = &metav1.Time{Time: start.Add(10 * time.Minute)}
+		Expect(*backupDuration(backup, now)).Should(Equal(10 * time.Minute))
+
+		By("running backup is measured until now")
+		backup.Status.CompletionTimestamp = nil
+		backup.Status.Phase = dpv1alpha1.BackupPhaseRunning
+		Expect(*backupDuration(backup, now)).Should(Equal(time.Hour))
+
+		By("failed backup without completion timestamp has no duration")
+		backup.Status.Phase = dpv1alpha1.BackupPhaseFailed
+		Expect(backupDuration(backup, now)).Should(BeNil())
 	})
 
 	It("restore", func() {
@@ -346,6 +1085,20 @@ var _ = Describe("DataProtection", func() {
 		dynamic := testing.FakeDynamicClient(backup)
 		tf.FakeDynamicClient = dynamic
 
+		By("restore should be failed when the backup is not completed")
+		o := &CreateRestoreOptions{}
+		o.Dynamic = tf.FakeDynamicClient
+		o.Namespace = testing.Namespace
+		o.RestoreSpec.BackupName = backupName
+		Expect(o.Validate()).Should(MatchError(ContainSubstring("only completed backup can be used to restore")))
+		o.RestoreSpec.BackupName = "not-exist"
+		Expect(o.Validate()).Should(HaveOccurred())
+
+		By("the backup is looked up with the overridden backup resource")
+		o.RestoreSpec.BackupName = backupName
+		o.BackupGVR = schema.GroupVersionResource{Group: types.DPAPIGroup, Version: types.DPAPIVersion, Resource: "backupjobs"}
+		Expect(o.Validate()).Should(MatchError(ContainSubstring(`backupjobs.dataprotection.kubeblocks.io "%s" not found`, backupName)))
+
 		By("restore new cluster from source cluster which is not deleted")
 		// mock backup is ok
 		mockBackupInfo(tf.FakeDynamicClient, backupName, clusterName, nil, "")
@@ -356,6 +1109,21 @@ var _ = Describe("DataProtection", func() {
 		newRestoreOps := &appsv1alpha1.OpsRequest{}
 		Expect(util.GetK8SClientObject(tf.FakeDynamicClient, newRestoreOps, types.OpsGVR(), testing.Namespace, newClusterName)).Should(Succeed())
 		Expect(clusterObj.Spec.ComponentSpecs[0].Replicas).Should(Equal(int32(1)))
+
+		By("wait for the restore to complete")
+		newRestoreOps.Status.Phase = appsv1alpha1.OpsSucceedPhase
+		o = &CreateRestoreOptions{}
+		o.Dynamic = testing.FakeDynamicClient(newRestoreOps)
+		o.Namespace = testing.Namespace
+		o.OpsRequestName = newClusterName
+		o.IOStreams = streams
+		Expect(o.WaitRestore()).Should(Succeed())
+		o.Wait = true
+		o.Timeout = time.Second
+		Expect(o.WaitRestore()).Should(Succeed())
+		newRestoreOps.Status.Phase = appsv1alpha1.OpsFailedPhase
+		o.Dynamic = testing.FakeDynamicClient(newRestoreOps)
+		Expect(o.WaitRestore()).Should(MatchError(ContainSubstring("is Failed")))
 	})
 
 	// It("restore-to-time", func() {
@@ -447,6 +1215,8 @@ var _ = Describe("DataProtection", func() {
 		backupName := "test1"
 		backup1 := testing.FakeBackup(backupName)
 		args = append(args, backupName)
+		backup1.Spec.DeletionPolicy = dpv1alpha1.BackupDeletionPolicyDelete
+		backup1.Spec.RetentionPeriod = "7d"
 		backup1.Status.Phase = dpv1alpha1.BackupPhaseCompleted
 		logNow := metav1.Now()
 		backup1.Status.StartTimestamp = &logNow
@@ -457,6 +1227,43 @@ var _ = Describe("DataProtection", func() {
 		Expect(o.Complete(args)).Should(Succeed())
 		o.client = testing.FakeClientSet()
 		Expect(o.Run()).Should(Succeed())
+
+		By("test describe-backup retries on transient errors")
+		calls := 0
+		fakeDynamic := testing.FakeDynamicClient(backup1)
+		fakeDynamic.PrependReactor("get", "backups", func(action clienttesting.Action) (bool, runtime.Object, error) {
+			calls++
+			if calls == 1 {
+				return true, nil, apierrors.NewTooManyRequests("too many requests", 0)
+			}
+			return false, nil, nil
+		})
+		tf.FakeDynamicClient = fakeDynamic
+		Expect(o.Complete(args)).Should(Succeed())
+		o.client = testing.FakeClientSet()
+		o.MaxRetries = 1
+		Expect(o.Run()).Should(Succeed())
+		Expect(calls).Should(Equal(2))
+
+		By("test describe-backup retries listing the events on transient errors")
+		calls = 0
+		client := testing.FakeClientSet()
+		client.PrependReactor("list", "events", func(action clienttesting.Action) (bool, runtime.Object, error) {
+			calls++
+			if calls == 1 {
+				return true, nil, apierrors.NewServiceUnavailable("service unavailable")
+			}
+			return false, nil, nil
+		})
+		tf.FakeDynamicClient = testing.FakeDynamicClient(backup1)
+		Expect(o.Complete(args)).Should(Succeed())
+		o.client = client
+		Expect(o.Run()).Should(Succeed())
+		Expect(calls).Should(Equal(2))
+
+		By("test describe-backup with negative max retries")
+		o.MaxRetries = -1
+		Expect(o.Complete(args)).Should(MatchError(ContainSubstring("--max-retries must be greater than or equal to 0")))
 	})
 
 	It("describe-backup-policy", func() {
@@ -474,12 +1281,16 @@ var _ = Describe("DataProtection", func() {
 		By("test describe-backup-policy with cluster")
 		policyName := "test1"
 		policy1 := testing.FakeBackupPolicy(policyName, testing.ClusterName)
-		tf.FakeDynamicClient = testing.FakeDynamicClient(policy1)
+		tf.FakeDynamicClient = testing.FakeDynamicClient(policy1, testing.FakeBackupSchedule("schedule", policyName),
+			testing.FakeBackupRepo("repo", true))
 		o.client = testing.FakeClientSet()
 		o.ClusterNames = []string{testing.ClusterName}
 		Expect(o.Complete()).Should(Succeed())
 		Expect(o.Validate()).Should(Succeed())
 		Expect(o.Run()).Should(Succeed())
+		Expect(out.String()).Should(ContainSubstring(testing.BackupMethodName))
+		Expect(out.String()).Should(ContainSubstring("0 0 * * *"))
+		Expect(out.String()).Should(ContainSubstring("1d"))
 
 		By("test describe-backup-policy with backupPolicy")
 		o = DescribeBackupPolicyOptions{
@@ -523,3 +1334,9 @@ func mockBackupInfo(dynamic dynamic.Interface, backupName, clusterName string, t
 		backupStatus, metav1.UpdateOptions{})
 	Expect(err).Should(Succeed())
 }
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -22,20 +22,27 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	apitypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/util/templates"
 
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
 
 	"github.com/apecloud/kbcli/pkg/action"
 	"github.com/apecloud/kbcli/pkg/types"
@@ -48,15 +55,40 @@ var (
 		kbcli cluster delete mycluster
 		# delete a cluster by label selector
 		kbcli cluster delete --selector clusterdefinition.kubeblocks.io/name=apecloud-mysql
+		# delete a cluster and wipe out its backups
+		kbcli cluster delete mycluster --termination-policy WipeOut
+		# delete a cluster and wait for it to be fully removed
+		kbcli cluster delete mycluster --wait --wait-timeout 5m
 `)
 
 	rbacEnabled = false
 )
 
 func NewDeleteCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
+	var (
+		terminationPolicy string
+		waitDeleted       bool
+		waitTimeout       time.Duration
+		// the deleted clusters to wait for
+		deletedClusters []*appsv1alpha1.Cluster
+	)
 	o := action.NewDeleteOptions(f, streams, types.ClusterGVR())
-	o.PreDeleteHook = clusterPreDeleteHook
-	o.PostDeleteHook = clusterPostDeleteHook
+	o.PreDeleteHook = func(o *action.DeleteOptions, object runtime.Object) error {
+		return clusterPreDeleteHook(o, object, terminationPolicy)
+	}
+	o.PostDeleteHook = func(o *action.DeleteOptions, object runtime.Object) error {
+		if err := clusterPostDeleteHook(o, object); err != nil {
+			return err
+		}
+		if waitDeleted && object != nil {
+			c, err := getClusterFromObject(object)
+			if err != nil {
+				return err
+			}
+			deletedClusters = append(deletedClusters, c)
+		}
+		return nil
+	}
 
 	cmd := &cobra.Command{
 		Use:               "delete NAME",
@@ -64,23 +96,126 @@ func NewDeleteCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.
 		Example:           deleteExample,
 		ValidArgsFunction: util.ResourceNameCompletionFunc(f, types.ClusterGVR()),
 		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(validateTerminationPolicy(terminationPolicy))
 			util.CheckErr(deleteCluster(o, args))
+			if waitDeleted {
+				util.CheckErr(waitClustersDeleted(o, deletedClusters, waitTimeout))
+			}
 		},
 	}
 	o.AddFlags(cmd)
 	cmd.Flags().BoolVar(&rbacEnabled, "rbac-enabled", false, "Specify whether rbac resources will be deleted by kbcli")
+	cmd.Flags().BoolVar(&waitDeleted, "wait", false, "Wait for the clusters to be fully removed")
+	cmd.Flags().DurationVar(&waitTimeout, "wait-timeout", 10*time.Minute, "Time to wait for the clusters to be removed, only valid if --wait is true, such as --wait-timeout=5m")
+	cmd.Flags().StringVar(&terminationPolicy, "termination-policy", "", "Update the termination policy of the cluster before deleting it, one of: (DoNotTerminate, Halt, Delete, WipeOut)")
+	util.CheckErr(cmd.RegisterFlagCompletionFunc(
+		"termination-policy",
+		func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return []string{
+				"DoNotTerminate\tblock delete operation",
+				"Halt\tdelete workload resources such as statefulset, deployment workloads but keep PVCs",
+				"Delete\tbased on Halt and deletes PVCs",
+				"WipeOut\tbased on Delete and wipe out all volume snapshots and snapshot data from backup storage location",
+			}, cobra.ShellCompDirectiveNoFileComp
+		}))
 	return cmd
 }
 
+func validateTerminationPolicy(policy string) error {
+	switch appsv1alpha1.TerminationPolicyType(policy) {
+	case "", appsv1alpha1.DoNotTerminate, appsv1alpha1.Halt, appsv1alpha1.Delete, appsv1alpha1.WipeOut:
+		return nil
+	}
+	return fmt.Errorf("invalid termination policy \"%s\", supported values: [DoNotTerminate, Halt, Delete, WipeOut]", policy)
+}
+
 func deleteCluster(o *action.DeleteOptions, args []string) error {
 	if len(args) == 0 && len(o.LabelSelector) == 0 {
 		return fmt.Errorf("missing cluster name or a lable selector")
 	}
 	o.Names = args
+	if err := warnClusterBackups(o, args); err != nil {
+		return err
+	}
 	return o.Run()
 }
 
-func clusterPreDeleteHook(o *action.DeleteOptions, object runtime.Object) error {
+// waitClustersDeleted waits for the deleted clusters to disappear, the cluster object is
+// removed after KubeBlocks cleans up its resources, which may take a while.
+func waitClustersDeleted(o *action.DeleteOptions, clusters []*appsv1alpha1.Cluster, timeout time.Duration) error {
+	if len(clusters) == 0 {
+		return nil
+	}
+	dynamic, err := o.Factory.DynamicClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	for _, c := range clusters {
+		fmt.Fprintf(o.Out, "Waiting for cluster %s to be removed...\n", c.Name)
+		if err = waitClusterDeleted(ctx, dynamic, c.Namespace, c.Name); err != nil {
+			return err
+		}
+		fmt.Fprintf(o.Out, "Cluster %s is removed\n", c.Name)
+	}
+	return nil
+}
+
+// waitClusterDeleted watches the cluster until it is deleted or the context is done.
+func waitClusterDeleted(ctx context.Context, dynamic dynamic.Interface, namespace, name string) error {
+	client := dynamic.Resource(types.ClusterGVR()).Namespace(namespace)
+	// the cluster may be already deleted before the watch starts
+	precondition := func(store cache.Store) (bool, error) {
+		exists, err := objectExists(store, namespace, name)
+		return !exists, err
+	}
+	err := watchObjectUntil(ctx, client, name, precondition, func(event watch.Event) (bool, error) {
+		return event.Type == watch.Deleted, nil
+	})
+	if err != nil && ctx.Err() != nil {
+		return fmt.Errorf("timed out waiting for cluster %s to be removed", name)
+	}
+	return err
+}
+
+// warnClusterBackups warns user about the backups referencing the clusters to be deleted,
+// these backups will be wiped out if the cluster termination policy is WipeOut.
+func warnClusterBackups(o *action.DeleteOptions, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	namespace, _, err := o.Factory.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+	dynamic, err := o.Factory.DynamicClient()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		backupList, err := dynamic.Resource(types.BackupGVR()).Namespace(namespace).List(context.TODO(), metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", constant.AppInstanceLabelKey, name),
+		})
+		if err != nil {
+			// the backups are only used for the warning, do not block deleting the cluster
+			fmt.Fprintf(o.ErrOut, "Warning: failed to check the backups of cluster %s: %v\n", name, err)
+			continue
+		}
+		if len(backupList.Items) == 0 {
+			continue
+		}
+		var backups []string
+		for _, b := range backupList.Items {
+			backups = append(backups, b.GetName())
+		}
+		fmt.Fprintf(o.ErrOut, "Warning: cluster %s is referenced by backups [%s], they will be wiped out if the termination policy is %s\n",
+			name, strings.Join(backups, " "), appsv1alpha1.WipeOut)
+	}
+	return nil
+}
+
+func clusterPreDeleteHook(o *action.DeleteOptions, object runtime.Object, terminationPolicy string) error {
 	if object == nil {
 		return nil
 	}
@@ -89,12 +224,34 @@ func clusterPreDeleteHook(o *action.DeleteOptions, object runtime.Object) error
 	if err != nil {
 		return err
 	}
+	policy := appsv1alpha1.TerminationPolicyType(terminationPolicy)
+	if len(policy) != 0 && cluster.Spec.TerminationPolicy != policy {
+		if err = patchTerminationPolicy(o, cluster, policy); err != nil {
+			return err
+		}
+	}
 	if cluster.Spec.TerminationPolicy == appsv1alpha1.DoNotTerminate {
 		return fmt.Errorf("cluster %s is protected by termination policy %s, skip deleting", cluster.Name, appsv1alpha1.DoNotTerminate)
 	}
 	return nil
 }
 
+// patchTerminationPolicy updates the cluster termination policy before deleting it
+func patchTerminationPolicy(o *action.DeleteOptions, cluster *appsv1alpha1.Cluster, policy appsv1alpha1.TerminationPolicyType) error {
+	dynamic, err := o.Factory.DynamicClient()
+	if err != nil {
+		return err
+	}
+	patch := fmt.Sprintf(`{"spec":{"terminationPolicy":"%s"}}`, policy)
+	if _, err = dynamic.Resource(types.ClusterGVR()).Namespace(cluster.Namespace).Patch(context.TODO(), cluster.Name,
+		apitypes.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "Cluster %s termination policy is updated to %s\n", cluster.Name, policy)
+	cluster.Spec.TerminationPolicy = policy
+	return nil
+}
+
 func clusterPostDeleteHook(o *action.DeleteOptions, object runtime.Object) error {
 	if object == nil {
 		return nil
@@ -162,9 +162,15 @@ func (o *describeOptions) describeCluster(name string) error {
 	showTopology(o.ClusterObjects.GetInstanceInfo(), o.Out)
 
 	comps := o.ClusterObjects.GetComponentInfo()
+	// components
+	showComponents(o.Cluster, comps, o.Out)
+
 	// resources
 	showResource(comps, o.Out)
 
+	// persistent volume claims
+	showPVCs(o.PVCs, o.Out)
+
 	// images
 	showImages(comps, o.Out)
 
@@ -221,6 +227,15 @@ func showTopology(instances []*cluster.InstanceInfo, out io.Writer) {
 	tbl.Print()
 }
 
+func showComponents(c *appsv1alpha1.Cluster, comps []*cluster.ComponentInfo, out io.Writer) {
+	tbl := newTbl(out, "\nComponents:", "COMPONENT", "TYPE", "REPLICAS(DESIRED/ACTUAL)", "STATUS")
+	for _, comp := range comps {
+		status := c.Status.Components[comp.Name].Phase
+		tbl.AddRow(comp.Name, comp.Type, comp.Replicas, util.CheckEmpty(string(status)))
+	}
+	tbl.Print()
+}
+
 func showResource(comps []*cluster.ComponentInfo, out io.Writer) {
 	tbl := newTbl(out, "\nResources Allocation:", "COMPONENT", "DEDICATED", "CPU(REQUEST/LIMIT)", "MEMORY(REQUEST/LIMIT)", "STORAGE-SIZE", "STORAGE-CLASS")
 	for _, c := range comps {
@@ -229,6 +244,28 @@ func showResource(comps []*cluster.ComponentInfo, out io.Writer) {
 	tbl.Print()
 }
 
+// showPVCs shows the storage class, capacity and status of the PVCs of the cluster, which helps
+// to diagnose the storage provisioning issues.
+func showPVCs(pvcs *corev1.PersistentVolumeClaimList, out io.Writer) {
+	tbl := newTbl(out, "\nPersistent Volume Claims:", "COMPONENT", "NAME", "STATUS", "CAPACITY", "STORAGE-CLASS")
+	if pvcs != nil {
+		for _, pvc := range pvcs.Items {
+			// the capacity is only available after the PVC is bound, use the requested size before that
+			capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]
+			if !ok {
+				capacity = pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+			}
+			var storageClass string
+			if pvc.Spec.StorageClassName != nil {
+				storageClass = *pvc.Spec.StorageClassName
+			}
+			tbl.AddRow(pvc.Labels[constant.KBAppComponentLabelKey], pvc.Name, util.CheckEmpty(string(pvc.Status.Phase)),
+				capacity.String(), util.CheckEmpty(storageClass))
+		}
+	}
+	tbl.Print()
+}
+
 func showImages(comps []*cluster.ComponentInfo, out io.Writer) {
 	tbl := newTbl(out, "\nImages:", "COMPONENT", "TYPE", "IMAGE")
 	for _, c := range comps {
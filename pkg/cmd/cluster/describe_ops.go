@@ -27,6 +27,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -178,17 +179,21 @@ func (o *describeOpsOptions) printOpsRequest(ops *appsv1alpha1.OpsRequest) error
 	// print the OpsRequest.status
 	o.printOpsRequestStatus(&ops.Status)
 
-	// print the OpsRequest.status.conditions
-	printer.PrintConditions(ops.Status.Conditions, o.Out)
+	// print the OpsRequest.status.conditions in chronological order
+	conditions := slices.Clone(ops.Status.Conditions)
+	sort.SliceStable(conditions, func(i, j int) bool {
+		return conditions[i].LastTransitionTime.Before(&conditions[j].LastTransitionTime)
+	})
+	printer.PrintConditions(conditions, o.Out)
 
-	// get all events about cluster
+	// get all events about the OpsRequest
 	events, err := o.client.CoreV1().Events(o.namespace).Search(scheme.Scheme, ops)
 	if err != nil {
 		return err
 	}
 
-	// print the warning events
-	printer.PrintAllWarningEvents(events, o.Out)
+	// print all events
+	printer.PrintAllEvents(events, o.Out)
 
 	return nil
 }
@@ -438,15 +443,15 @@ func (o *describeOpsOptions) printProgressDetails(opsStatus *appsv1alpha1.OpsReq
 	keys := maps.Keys(opsStatus.Components)
 	sort.Strings(keys)
 	tbl := printer.NewTablePrinter(o.Out)
-	tbl.SetHeader(fmt.Sprintf("%-22s%s", "", "OBJECT-KEY"), "STATUS", "DURATION", "MESSAGE")
+	tbl.SetHeader("COMPONENT", "PHASE", "OBJECT-KEY", "STATUS", "DURATION", "MESSAGE")
 	for _, cName := range keys {
-		progressDetails := opsStatus.Components[cName].ProgressDetails
-		for _, v := range progressDetails {
+		compStatus := opsStatus.Components[cName]
+		for _, v := range compStatus.ProgressDetails {
 			var groupStr string
 			if len(v.Group) > 0 {
 				groupStr = fmt.Sprintf("(%s)", v.Group)
 			}
-			tbl.AddRow(fmt.Sprintf("%-22s%s%s", "", v.ObjectKey, groupStr),
+			tbl.AddRow(cName, compStatus.Phase, v.ObjectKey+groupStr,
 				v.Status, util.GetHumanReadableDuration(v.StartTime, v.EndTime), v.Message)
 		}
 	}
@@ -272,6 +272,13 @@ var _ = Describe("Expose", func() {
 			ops.Status = fakeOpsStatusAndProgress()
 		})
 
+		By("test printing component progress details")
+		o := newDescribeOpsOptions(tf, streams)
+		opsStatus := fakeOpsStatusAndProgress()
+		o.printProgressDetails(&opsStatus)
+		Expect(clitesting.ContainExpectStrings(o.Out.(*bytes.Buffer).String(), "COMPONENT", componentName,
+			string(appsv1alpha1.FailedClusterCompPhase), "Pod/test-pod-wessxd")).Should(BeTrue())
+
 		By("test printing OpsRequest last configuration")
 		testPrintLastConfiguration(appsv1alpha1.LastConfiguration{
 			ClusterVersionRef: clusterVersionName,
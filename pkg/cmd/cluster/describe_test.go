@@ -27,6 +27,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
@@ -35,8 +36,10 @@ import (
 	clientfake "k8s.io/client-go/rest/fake"
 	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
 
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
 	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
 
+	clusterutil "github.com/apecloud/kbcli/pkg/cluster"
 	"github.com/apecloud/kbcli/pkg/testing"
 	"github.com/apecloud/kbcli/pkg/types"
 )
@@ -106,6 +109,30 @@ var _ = Describe("Expose", func() {
 		Expect(o.run()).Should(Succeed())
 	})
 
+	It("showComponents", func() {
+		out := &bytes.Buffer{}
+		c := testing.FakeCluster("test-cluster", namespace)
+		c.Status.Components[testing.ComponentName] = appsv1alpha1.ClusterComponentStatus{Phase: appsv1alpha1.RunningClusterCompPhase}
+		showComponents(c, []*clusterutil.ComponentInfo{{Name: testing.ComponentName, Type: testing.ComponentDefName, Replicas: "1 / 1"}}, out)
+		Expect(out.String()).Should(ContainSubstring(testing.ComponentName))
+		Expect(out.String()).Should(ContainSubstring(string(appsv1alpha1.RunningClusterCompPhase)))
+	})
+
+	It("showPVCs", func() {
+		out := &bytes.Buffer{}
+		pvcs := testing.FakePVCs()
+		showPVCs(pvcs, out)
+		Expect(out.String()).Should(ContainSubstring("Persistent Volume Claims:"))
+		Expect(out.String()).Should(MatchRegexp("%s\\s+%s\\s+<none>\\s+1Gi\\s+%s", testing.ComponentName, testing.PVCName, testing.StorageClassName))
+
+		By("show the capacity of the bound PVC")
+		out.Reset()
+		pvcs.Items[0].Status.Phase = corev1.ClaimBound
+		pvcs.Items[0].Status.Capacity = corev1.ResourceList{corev1.ResourceStorage: apiresource.MustParse("2Gi")}
+		showPVCs(pvcs, out)
+		Expect(out.String()).Should(MatchRegexp("%s\\s+Bound\\s+2Gi", testing.PVCName))
+	})
+
 	It("showEvents", func() {
 		out := &bytes.Buffer{}
 		showEvents("test-cluster", namespace, out)
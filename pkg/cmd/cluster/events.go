@@ -0,0 +1,366 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
+	clientset "k8s.io/client-go/kubernetes"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+
+	"github.com/apecloud/kbcli/pkg/printer"
+	"github.com/apecloud/kbcli/pkg/types"
+	"github.com/apecloud/kbcli/pkg/util"
+)
+
+var eventsExample = templates.Examples(`
+	# list the events of a cluster and its instances and services
+	kbcli cluster events mycluster
+
+	# list the warning events in the last hour
+	kbcli cluster events mycluster --types Warning --since 1h
+
+	# list the events and watch for new events
+	kbcli cluster events mycluster --watch`)
+
+type eventsOptions struct {
+	factory   cmdutil.Factory
+	client    clientset.Interface
+	dynamic   dynamic.Interface
+	namespace string
+	name      string
+
+	since time.Duration
+	types []string
+	watch bool
+
+	// unrelated records the pods and services known not to belong to the cluster
+	unrelated sets.Set[string]
+	// printed records the printed versions of the events, to avoid printing them again after relisting
+	printed sets.Set[string]
+
+	genericiooptions.IOStreams
+}
+
+func NewEventsCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &eventsOptions{factory: f, IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:               "events NAME",
+		Short:             "List the events of a cluster and its instances and services.",
+		Example:           eventsExample,
+		ValidArgsFunction: util.ResourceNameCompletionFunc(f, types.ClusterGVR()),
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(args))
+			util.CheckErr(o.run())
+		},
+	}
+	cmd.Flags().DurationVar(&o.since, "since", o.since, "Only list the events newer than a relative duration like 5s, 2m, or 3h. Defaults to all events.")
+	cmd.Flags().StringSliceVar(&o.types, "types", o.types, "Only list the events of the given types, supported types are Normal and Warning. Defaults to all types.")
+	cmd.Flags().BoolVarP(&o.watch, "watch", "w", o.watch, "After listing the events, watch for new events.")
+	return cmd
+}
+
+func (o *eventsOptions) complete(args []string) error {
+	var err error
+	if len(args) != 1 {
+		return fmt.Errorf("only one cluster name should be specified")
+	}
+	o.name = args[0]
+	if o.since < 0 {
+		return fmt.Errorf("--since must be greater than 0")
+	}
+	for i, t := range o.types {
+		switch {
+		case strings.EqualFold(t, corev1.EventTypeNormal):
+			o.types[i] = corev1.EventTypeNormal
+		case strings.EqualFold(t, corev1.EventTypeWarning):
+			o.types[i] = corev1.EventTypeWarning
+		default:
+			return fmt.Errorf("invalid event type \"%s\", supported types are %s and %s", t, corev1.EventTypeNormal, corev1.EventTypeWarning)
+		}
+	}
+	if o.client, err = o.factory.KubernetesClientSet(); err != nil {
+		return err
+	}
+	if o.dynamic, err = o.factory.DynamicClient(); err != nil {
+		return err
+	}
+	if o.namespace, _, err = o.factory.ToRawKubeConfigLoader().Namespace(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *eventsOptions) run() error {
+	ctx := context.TODO()
+	if _, err := o.dynamic.Resource(types.ClusterGVR()).Namespace(o.namespace).Get(ctx, o.name, metav1.GetOptions{}); err != nil {
+		return err
+	}
+	objects, err := o.involvedObjects(ctx)
+	if err != nil {
+		return err
+	}
+	o.unrelated = sets.New[string]()
+	o.printed = sets.New[string]()
+
+	events, resourceVersion, err := o.listEvents(ctx, objects)
+	if err != nil {
+		return err
+	}
+	if len(events.Items) == 0 {
+		fmt.Fprintf(o.Out, "No events found in cluster %s\n", o.name)
+	} else {
+		tbl := printer.NewTablePrinter(o.Out)
+		tbl.SetHeader("TIME", "TYPE", "REASON", "OBJECT", "MESSAGE")
+		for _, obj := range *util.SortEventsByLastTimestamp(events, "") {
+			e := obj.(*corev1.Event)
+			tbl.AddRow(util.GetEventTimeStr(e), e.Type, e.Reason, util.GetEventObject(e), e.Message)
+		}
+		tbl.Print()
+	}
+
+	if !o.watch {
+		return nil
+	}
+	return o.watchEvents(ctx, objects, resourceVersion)
+}
+
+// involvedObjects returns the objects whose events should be listed, including the cluster,
+// its pods and services, each object is identified by its kind and name.
+func (o *eventsOptions) involvedObjects(ctx context.Context) (sets.Set[string], error) {
+	listOpts := metav1.ListOptions{LabelSelector: util.BuildLabelSelectorByNames("", []string{o.name})}
+	objects := sets.New(eventObjectKey(types.KindCluster, o.name))
+	pods, err := o.client.CoreV1().Pods(o.namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range pods.Items {
+		objects.Insert(eventObjectKey("Pod", pod.Name))
+	}
+	svcs, err := o.client.CoreV1().Services(o.namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, err
+	}
+	for _, svc := range svcs.Items {
+		objects.Insert(eventObjectKey("Service", svc.Name))
+	}
+	return objects, nil
+}
+
+// listEvents lists the matched events of the involved objects which are not printed yet, the events
+// of each object are listed with the involvedObject field selectors rather than listing all events
+// in the namespace. It returns the resource version of the first list, so that watching from it will
+// not miss the events created while listing.
+func (o *eventsOptions) listEvents(ctx context.Context, objects sets.Set[string]) (*corev1.EventList, string, error) {
+	var (
+		result          = &corev1.EventList{}
+		resourceVersion string
+		now             = time.Now()
+	)
+	for _, key := range sets.List(objects) {
+		kind, name, _ := strings.Cut(key, "/")
+		events, err := o.client.CoreV1().Events(o.namespace).List(ctx, metav1.ListOptions{FieldSelector: o.fieldSelector(kind, name)})
+		if err != nil {
+			return nil, "", err
+		}
+		if resourceVersion == "" {
+			resourceVersion = events.ResourceVersion
+		}
+		for i := range events.Items {
+			e := &events.Items[i]
+			if o.printed.Has(eventVersionKey(e)) || !o.match(e, objects, now) {
+				continue
+			}
+			o.printed.Insert(eventVersionKey(e))
+			result.Items = append(result.Items, *e)
+		}
+	}
+	return result, resourceVersion, nil
+}
+
+// fieldSelector returns the field selector of the events of the given object, the events of all objects
+// are selected if the kind and name are empty. The type is selected too if only one type is specified.
+func (o *eventsOptions) fieldSelector(kind, name string) string {
+	selector := fields.Set{}
+	if kind != "" {
+		selector["involvedObject.kind"] = kind
+		selector["involvedObject.name"] = name
+	}
+	if len(o.types) == 1 {
+		selector["type"] = o.types[0]
+	}
+	return selector.String()
+}
+
+// match checks if the event is involved with the objects and matches the filters of --since and --types.
+func (o *eventsOptions) match(e *corev1.Event, objects sets.Set[string], now time.Time) bool {
+	if !objects.Has(eventObjectKey(e.InvolvedObject.Kind, e.InvolvedObject.Name)) {
+		return false
+	}
+	if len(o.types) > 0 && !sets.New(o.types...).Has(e.Type) {
+		return false
+	}
+	if o.since > 0 {
+		t := e.CreationTimestamp.Time
+		if !e.LastTimestamp.IsZero() {
+			t = e.LastTimestamp.Time
+		}
+		if t.Before(now.Add(-o.since)) {
+			return false
+		}
+	}
+	return true
+}
+
+// watchEvents watches the events from the given resource version and prints the matched events
+// until the user interrupts the command.
+func (o *eventsOptions) watchEvents(ctx context.Context, objects sets.Set[string], resourceVersion string) error {
+	// stop watching if the user interrupts the command
+	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	fmt.Fprintln(o.Out, "\nWatching the events of cluster, press Ctrl+C to stop")
+	for {
+		w, err := o.client.CoreV1().Events(o.namespace).Watch(ctx, metav1.ListOptions{
+			FieldSelector:   o.fieldSelector("", ""),
+			ResourceVersion: resourceVersion,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		err = o.printEvents(ctx, o.Out, w, objects, &resourceVersion)
+		if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+			// the resource version is too old to watch from, relist the events to catch up
+			var events *corev1.EventList
+			if events, resourceVersion, err = o.listEvents(ctx, objects); err != nil {
+				return err
+			}
+			for _, obj := range *util.SortEventsByLastTimestamp(events, "") {
+				printEvent(o.Out, obj.(*corev1.Event))
+			}
+			continue
+		}
+		if err != nil || ctx.Err() != nil {
+			return err
+		}
+		// the watch is closed by server, watch again from the last resource version
+	}
+}
+
+// printEvents prints the matched events received from the watcher until the watcher is closed or
+// the context is done.
+func (o *eventsOptions) printEvents(ctx context.Context, out io.Writer, w watch.Interface, objects sets.Set[string], resourceVersion *string) error {
+	defer w.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return nil
+			}
+			if event.Type == watch.Error {
+				return apierrors.FromObject(event.Object)
+			}
+			e, ok := event.Object.(*corev1.Event)
+			if !ok {
+				continue
+			}
+			*resourceVersion = e.ResourceVersion
+			if event.Type == watch.Deleted || o.printed.Has(eventVersionKey(e)) {
+				continue
+			}
+			if err := o.checkInvolvedObject(ctx, objects, e.InvolvedObject.Kind, e.InvolvedObject.Name); err != nil {
+				return err
+			}
+			if !o.match(e, objects, time.Now()) {
+				continue
+			}
+			o.printed.Insert(eventVersionKey(e))
+			printEvent(out, e)
+		}
+	}
+}
+
+// checkInvolvedObject adds the pod or service to the objects if it belongs to the cluster. The pods and
+// services created after listing are not in the objects, so an unknown one is fetched once to check its
+// instance label, and the ones that do not belong to the cluster are recorded to avoid fetching them again.
+func (o *eventsOptions) checkInvolvedObject(ctx context.Context, objects sets.Set[string], kind, name string) error {
+	key := eventObjectKey(kind, name)
+	if (kind != "Pod" && kind != "Service") || objects.Has(key) || o.unrelated.Has(key) {
+		return nil
+	}
+	var (
+		obj metav1.Object
+		err error
+	)
+	if kind == "Pod" {
+		obj, err = o.client.CoreV1().Pods(o.namespace).Get(ctx, name, metav1.GetOptions{})
+	} else {
+		obj, err = o.client.CoreV1().Services(o.namespace).Get(ctx, name, metav1.GetOptions{})
+	}
+	switch {
+	case apierrors.IsNotFound(err):
+		o.unrelated.Insert(key)
+	case err != nil:
+		return err
+	case obj.GetLabels()[constant.AppInstanceLabelKey] == o.name:
+		objects.Insert(key)
+	default:
+		o.unrelated.Insert(key)
+	}
+	return nil
+}
+
+func printEvent(out io.Writer, e *corev1.Event) {
+	fmt.Fprintf(out, "%s\t%s\t%s\t%s\t%s\n", util.GetEventTimeStr(e), e.Type, e.Reason, util.GetEventObject(e), e.Message)
+}
+
+func eventObjectKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+// eventVersionKey identifies a version of the event, an event is updated with a new resource version
+// when it occurs again.
+func eventVersionKey(e *corev1.Event) string {
+	return e.Name + "/" + e.ResourceVersion
+}
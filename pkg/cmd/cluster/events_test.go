@@ -0,0 +1,197 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	clientfake "k8s.io/client-go/rest/fake"
+	clienttesting "k8s.io/client-go/testing"
+	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
+
+	"github.com/apecloud/kbcli/pkg/testing"
+	"github.com/apecloud/kbcli/pkg/types"
+)
+
+var _ = Describe("events", func() {
+	var (
+		streams genericiooptions.IOStreams
+		out     *bytes.Buffer
+		tf      *cmdtesting.TestFactory
+	)
+
+	fakeEvent := func(name, kind, objName, eventType string, lastTime time.Time) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: testing.Namespace},
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Name: objName},
+			Type:           eventType,
+			Reason:         name,
+			LastTimestamp:  metav1.NewTime(lastTime),
+		}
+	}
+
+	BeforeEach(func() {
+		streams, _, out, _ = genericiooptions.NewTestIOStreams()
+		tf = cmdtesting.NewTestFactory().WithNamespace(testing.Namespace)
+		tf.Client = &clientfake.RESTClient{}
+	})
+
+	AfterEach(func() {
+		tf.Cleanup()
+	})
+
+	It("events command", func() {
+		cmd := NewEventsCmd(tf, streams)
+		Expect(cmd).ShouldNot(BeNil())
+		Expect(cmd.Flags().Lookup("since")).ShouldNot(BeNil())
+		Expect(cmd.Flags().Lookup("types")).ShouldNot(BeNil())
+		Expect(cmd.Flags().Lookup("watch")).ShouldNot(BeNil())
+
+		o := &eventsOptions{factory: tf, IOStreams: streams}
+		Expect(o.complete(nil)).Should(HaveOccurred())
+		o.types = []string{"Unknown"}
+		Expect(o.complete([]string{testing.ClusterName})).Should(HaveOccurred())
+		o.types = []string{"warning"}
+		Expect(o.complete([]string{testing.ClusterName})).Should(Succeed())
+		Expect(o.types).Should(Equal([]string{corev1.EventTypeWarning}))
+	})
+
+	It("list events", func() {
+		now := time.Now()
+		pods := testing.FakePods(1, testing.Namespace, testing.ClusterName)
+		objs := []runtime.Object{
+			pods,
+			fakeEvent("cluster-event", types.KindCluster, testing.ClusterName, corev1.EventTypeNormal, now.Add(-time.Minute)),
+			fakeEvent("pod-event", "Pod", pods.Items[0].Name, corev1.EventTypeWarning, now),
+			fakeEvent("old-event", "Pod", pods.Items[0].Name, corev1.EventTypeNormal, now.Add(-2*time.Hour)),
+			fakeEvent("other-event", "Pod", "other-pod", corev1.EventTypeWarning, now),
+		}
+		o := &eventsOptions{
+			client:    testing.FakeClientSet(objs...),
+			dynamic:   testing.FakeDynamicClient(testing.FakeCluster(testing.ClusterName, testing.Namespace)),
+			namespace: testing.Namespace,
+			name:      testing.ClusterName,
+			IOStreams: streams,
+		}
+
+		By("list all events of the cluster")
+		Expect(o.run()).Should(Succeed())
+		Expect(out.String()).Should(ContainSubstring("cluster-event"))
+		Expect(out.String()).Should(ContainSubstring("pod-event"))
+		Expect(out.String()).Should(ContainSubstring("old-event"))
+		Expect(out.String()).ShouldNot(ContainSubstring("other-event"))
+
+		By("list the events with --since and --types")
+		out.Reset()
+		o.since = time.Hour
+		o.types = []string{corev1.EventTypeNormal}
+		Expect(o.run()).Should(Succeed())
+		Expect(out.String()).Should(ContainSubstring("cluster-event"))
+		Expect(out.String()).ShouldNot(ContainSubstring("pod-event"))
+		Expect(out.String()).ShouldNot(ContainSubstring("old-event"))
+
+		By("list the events of a cluster that does not exist")
+		o.name = "not-exist"
+		Expect(o.run()).Should(HaveOccurred())
+	})
+
+	It("watch events", func() {
+		pods := testing.FakePods(2, testing.Namespace, testing.ClusterName)
+		client := testing.FakeClientSet(pods)
+		o := &eventsOptions{
+			client:    client,
+			namespace: testing.Namespace,
+			name:      testing.ClusterName,
+			unrelated: sets.New[string](),
+			printed:   sets.New[string](),
+			IOStreams: streams,
+		}
+		objects, err := o.involvedObjects(context.Background())
+		Expect(err).Should(Succeed())
+		Expect(objects).Should(Equal(sets.New("Cluster/"+testing.ClusterName, "Pod/"+pods.Items[0].Name, "Pod/"+pods.Items[1].Name)))
+		// the second pod is created after listing
+		objects.Delete("Pod/" + pods.Items[1].Name)
+
+		w := watch.NewFakeWithChanSize(5, false)
+		for i, e := range []*corev1.Event{
+			fakeEvent("pod-event", "Pod", pods.Items[0].Name, corev1.EventTypeWarning, time.Now()),
+			fakeEvent("new-pod-event", "Pod", pods.Items[1].Name, corev1.EventTypeWarning, time.Now()),
+			fakeEvent("other-event", "Pod", "other-pod", corev1.EventTypeWarning, time.Now()),
+			fakeEvent("other-event-again", "Pod", "other-pod", corev1.EventTypeWarning, time.Now()),
+		} {
+			e.ResourceVersion = strconv.Itoa(10 + i)
+			w.Add(e)
+		}
+		w.Stop()
+
+		resourceVersion := ""
+		client.ClearActions()
+		Expect(o.printEvents(context.Background(), out, w, objects, &resourceVersion)).Should(Succeed())
+		Expect(out.String()).Should(ContainSubstring("pod-event"))
+		Expect(out.String()).Should(ContainSubstring("new-pod-event"))
+		Expect(out.String()).ShouldNot(ContainSubstring("other-event"))
+		Expect(resourceVersion).Should(Equal("13"))
+		By("the unknown pods are only fetched once")
+		Expect(client.Actions()).Should(HaveLen(2))
+	})
+
+	It("relist events if the resource version is expired", func() {
+		pods := testing.FakePods(1, testing.Namespace, testing.ClusterName)
+		printed := fakeEvent("printed-event", "Pod", pods.Items[0].Name, corev1.EventTypeNormal, time.Now())
+		missed := fakeEvent("missed-event", "Pod", pods.Items[0].Name, corev1.EventTypeNormal, time.Now())
+		client := testing.FakeClientSet(pods, printed, missed)
+		watches := 0
+		client.PrependWatchReactor("events", func(action clienttesting.Action) (bool, watch.Interface, error) {
+			watches++
+			if watches > 1 {
+				return true, nil, fmt.Errorf("stop watching")
+			}
+			w := watch.NewFakeWithChanSize(1, false)
+			w.Error(&apierrors.NewResourceExpired("too old resource version").ErrStatus)
+			return true, w, nil
+		})
+		o := &eventsOptions{
+			client:    client,
+			namespace: testing.Namespace,
+			name:      testing.ClusterName,
+			unrelated: sets.New[string](),
+			printed:   sets.New(eventVersionKey(printed)),
+			IOStreams: streams,
+		}
+		objects := sets.New("Pod/" + pods.Items[0].Name)
+		Expect(o.watchEvents(context.Background(), objects, "1")).Should(MatchError("stop watching"))
+		Expect(watches).Should(Equal(2))
+		Expect(out.String()).Should(ContainSubstring("missed-event"))
+		Expect(out.String()).ShouldNot(ContainSubstring("printed-event"))
+	})
+})
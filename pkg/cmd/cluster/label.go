@@ -195,46 +195,8 @@ func (o *LabelOptions) run() error {
 			if err != nil {
 				return err
 			}
-		} else {
-			name, namespace := info.Name, info.Namespace
-			if err != nil {
-				return err
-			}
-			accessor, err := meta.Accessor(obj)
-			if err != nil {
-				return err
-			}
-			for _, label := range o.removeLabels {
-				if _, ok := accessor.GetLabels()[label]; !ok {
-					fmt.Fprintf(o.Out, "label %q not found.\n", label)
-				}
-			}
-
-			if err := labelFunc(obj, o.overwrite, o.newLabels, o.removeLabels); err != nil {
-				return err
-			}
-
-			newObj, err := json.Marshal(obj)
-			if err != nil {
-				return err
-			}
-			patchBytes, err := jsonpatch.CreateMergePatch(oldData, newObj)
-			createPatch := err == nil
-			mapping := info.ResourceMapping()
-			client, err := o.unstructuredClientForMapping(mapping)
-			if err != nil {
-				return err
-			}
-			helper := resource.NewHelper(client, mapping).
-				DryRun(o.dryRunStrategy == cmdutil.DryRunServer)
-			if createPatch {
-				_, err = helper.Patch(namespace, name, ktypes.MergePatchType, patchBytes, nil)
-			} else {
-				_, err = helper.Replace(namespace, name, false, obj)
-			}
-			if err != nil {
-				return err
-			}
+		} else if err = o.patchLabels(info, oldData); err != nil {
+			return err
 		}
 	}
 
@@ -265,6 +227,48 @@ func (o *LabelOptions) run() error {
 	return nil
 }
 
+// patchLabels updates the labels of the object in info and patches it to the server,
+// oldData is the JSON of the object before the labels are updated.
+func (o *LabelOptions) patchLabels(info *resource.Info, oldData []byte) error {
+	obj := info.Object
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	for _, label := range o.removeLabels {
+		if _, ok := accessor.GetLabels()[label]; !ok {
+			fmt.Fprintf(o.Out, "label %q not found.\n", label)
+		}
+	}
+
+	if err = labelFunc(obj, o.overwrite, o.newLabels, o.removeLabels); err != nil {
+		return err
+	}
+
+	newObj, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	patchBytes, err := jsonpatch.CreateMergePatch(oldData, newObj)
+	createPatch := err == nil
+	mapping := info.ResourceMapping()
+	if mapping == nil {
+		return fmt.Errorf("failed to get the resource mapping of %s/%s", info.Namespace, info.Name)
+	}
+	client, err := o.unstructuredClientForMapping(mapping)
+	if err != nil {
+		return err
+	}
+	helper := resource.NewHelper(client, mapping).
+		DryRun(o.dryRunStrategy == cmdutil.DryRunServer)
+	if createPatch {
+		_, err = helper.Patch(info.Namespace, info.Name, ktypes.MergePatchType, patchBytes, nil)
+	} else {
+		_, err = helper.Replace(info.Namespace, info.Name, false, obj)
+	}
+	return err
+}
+
 func parseLabels(spec []string) (map[string]string, []string, error) {
 	labels := map[string]string{}
 	var remove []string
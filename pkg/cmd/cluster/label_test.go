@@ -20,13 +20,21 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package cluster
 
 import (
+	"encoding/json"
+	"fmt"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/resource"
 	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
 
+	"github.com/apecloud/kbcli/pkg/testing"
 	"github.com/apecloud/kbcli/pkg/types"
 )
 
@@ -81,4 +89,27 @@ var _ = Describe("cluster label", func() {
 			Expect(o.complete(cmd, []string{"c1", "env=dev", "env-"})).Should(HaveOccurred())
 		})
 	})
+
+	It("report the errors of patching labels", func() {
+		o := NewLabelOptions(tf, streams, types.ClusterGVR())
+		o.newLabels = map[string]string{"env": "dev"}
+		newInfo := func() *resource.Info {
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(testing.FakeCluster("c1", "default"))
+			Expect(err).Should(Succeed())
+			return &resource.Info{Name: "c1", Namespace: "default", Object: &unstructured.Unstructured{Object: obj}}
+		}
+		oldData, err := json.Marshal(newInfo().Object)
+		Expect(err).Should(Succeed())
+
+		By("the resource mapping is missing")
+		Expect(o.patchLabels(newInfo(), oldData)).Should(MatchError(ContainSubstring("failed to get the resource mapping of default/c1")))
+
+		By("failed to get the client of the mapping")
+		info := newInfo()
+		info.Mapping = &meta.RESTMapping{Resource: types.ClusterGVR()}
+		o.unstructuredClientForMapping = func(mapping *meta.RESTMapping) (resource.RESTClient, error) {
+			return nil, fmt.Errorf("no client for %s", mapping.Resource.Resource)
+		}
+		Expect(o.patchLabels(info, oldData)).Should(MatchError("no client for clusters"))
+	})
 })
@@ -20,20 +20,34 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package cluster
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/util/templates"
 
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+
 	"github.com/apecloud/kbcli/pkg/action"
 	"github.com/apecloud/kbcli/pkg/cluster"
 	"github.com/apecloud/kbcli/pkg/printer"
 	"github.com/apecloud/kbcli/pkg/types"
 	"github.com/apecloud/kbcli/pkg/util"
+	"github.com/apecloud/kbcli/pkg/util/flags"
 )
 
 var (
@@ -44,14 +58,27 @@ var (
 		# list a single cluster with specified name
 		kbcli cluster list mycluster
 
+		# list the clusters with specified names
+		kbcli cluster list mycluster1 mycluster2
+
 		# list a single cluster in YAML output format
 		kbcli cluster list mycluster -o yaml
 
 		# list a single cluster in JSON output format
 		kbcli cluster list mycluster -o json
 
+		# list the names of all clusters with a Go template
+		kbcli cluster list -o template='{{.metadata.name}}'
+
 		# list a single cluster in wide output format
-		kbcli cluster list mycluster -o wide`)
+		kbcli cluster list mycluster -o wide
+
+		# list all clusters created from the specified cluster definition,
+		# equivalent to "-l clusterdefinition.kubeblocks.io/name=apecloud-mysql"
+		kbcli cluster list --cluster-definition apecloud-mysql
+
+		# list all clusters and watch the status transitions of them
+		kbcli cluster list --watch`)
 
 	listInstancesExample = templates.Examples(`
 		# list all instances of all clusters in current namespace
@@ -76,23 +103,38 @@ var (
 )
 
 func NewListCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
+	var (
+		clusterDefinition string
+		watchStatus       bool
+	)
 	o := action.NewListOptions(f, streams, types.ClusterGVR())
 	cmd := &cobra.Command{
-		Use:               "list [NAME]",
+		Use:               "list [NAME...]",
 		Short:             "List clusters.",
 		Example:           listExample,
 		Aliases:           []string{"ls"},
 		ValidArgsFunction: util.ResourceNameCompletionFunc(f, o.GVR),
 		Run: func(cmd *cobra.Command, args []string) {
+			if len(clusterDefinition) != 0 {
+				o.LabelSelector = util.BuildClusterLabel(o.LabelSelector, []string{clusterDefinition})
+			}
 			o.Names = args
+			if watchStatus && !o.Format.IsHumanReadable() {
+				util.CheckErr(fmt.Errorf("--watch is only supported with table or wide output format"))
+			}
 			if o.Format == printer.Wide {
 				util.CheckErr(run(o, cluster.PrintWide))
 			} else {
 				util.CheckErr(run(o, cluster.PrintClusters))
 			}
+			if watchStatus {
+				util.CheckErr(watchClusterTransitions(cmd.Context(), o))
+			}
 		},
 	}
 	o.AddFlags(cmd)
+	flags.AddClusterDefinitionFlag(f, cmd, &clusterDefinition)
+	cmd.Flags().BoolVarP(&watchStatus, "watch", "w", false, "After listing the clusters, watch and print the status transitions of them, with the time of the transitions")
 	return cmd
 }
 
@@ -151,8 +193,8 @@ func NewListEventsCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *co
 }
 
 func run(o *action.ListOptions, printType cluster.PrintType) error {
-	// if format is JSON or YAML, use default printer to output the result.
-	if o.Format == printer.JSON || o.Format == printer.YAML {
+	// if format is JSON, YAML or template, use default printer to output the result.
+	if o.Format == printer.JSON || o.Format == printer.YAML || o.Format == printer.Template {
 		_, err := o.Run()
 		return err
 	}
@@ -216,3 +258,147 @@ func addRow(dynamic dynamic.Interface, client *kubernetes.Clientset,
 	printer.AddRow(clusterObjs)
 	return nil
 }
+
+// watchClusterTransitions watches the clusters and prints a line when the status of a cluster
+// changes, the previous status is tracked by cluster UID.
+func watchClusterTransitions(ctx context.Context, o *action.ListOptions) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	// stop watching if the user interrupts the command
+	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	dynamic, err := o.Factory.DynamicClient()
+	if err != nil {
+		return err
+	}
+	namespace := o.Namespace
+	if o.AllNamespaces {
+		namespace = ""
+	}
+	client := dynamic.Resource(types.ClusterGVR()).Namespace(namespace)
+	names := sets.New(o.Names...)
+	phases := map[string]string{}
+	resourceVersion, err := syncClusterPhases(ctx, o.Out, client, o.LabelSelector, names, phases)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(o.Out, "\nWatching the status transitions of clusters, press Ctrl+C to stop")
+	for {
+		w, err := client.Watch(ctx, metav1.ListOptions{LabelSelector: o.LabelSelector, ResourceVersion: resourceVersion})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		err = printClusterTransitions(ctx, o.Out, w, names, phases, &resourceVersion)
+		if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+			// the resource version is too old to watch from, relist the clusters to catch up
+			if resourceVersion, err = syncClusterPhases(ctx, o.Out, client, o.LabelSelector, names, phases); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil || ctx.Err() != nil {
+			return err
+		}
+		// the watch is closed by server, watch again from the last resource version
+	}
+}
+
+// syncClusterPhases lists the clusters and updates the phases tracked by cluster UID, the transitions
+// of the tracked clusters since the last sync are printed. It returns the resource version of the list.
+func syncClusterPhases(ctx context.Context, out io.Writer, client dynamic.ResourceInterface, selector string,
+	names sets.Set[string], phases map[string]string) (string, error) {
+	list, err := client.List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return "", err
+	}
+	latest := make(map[string]string, len(list.Items))
+	for i := range list.Items {
+		obj := &list.Items[i]
+		uid, phase := string(obj.GetUID()), clusterPhase(obj)
+		latest[uid] = phase
+		if prev, ok := phases[uid]; ok && prev != phase {
+			printClusterTransition(out, obj, names, prev, phase)
+		}
+	}
+	for uid := range phases {
+		delete(phases, uid)
+	}
+	for uid, phase := range latest {
+		phases[uid] = phase
+	}
+	return list.GetResourceVersion(), nil
+}
+
+// printClusterTransitions prints the status transitions of the clusters received from the watcher
+// until the watcher is closed or the context is done.
+func printClusterTransitions(ctx context.Context, out io.Writer, w watch.Interface, names sets.Set[string],
+	phases map[string]string, resourceVersion *string) error {
+	defer w.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return nil
+			}
+			if event.Type == watch.Error {
+				return apierrors.FromObject(event.Object)
+			}
+			obj, ok := event.Object.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			*resourceVersion = obj.GetResourceVersion()
+			uid := string(obj.GetUID())
+			switch event.Type {
+			case watch.Added:
+				phases[uid] = clusterPhase(obj)
+			case watch.Deleted:
+				delete(phases, uid)
+			case watch.Modified:
+				prev, phase := phases[uid], clusterPhase(obj)
+				phases[uid] = phase
+				if prev != phase {
+					printClusterTransition(out, obj, names, prev, phase)
+				}
+			}
+		}
+	}
+}
+
+// printClusterTransition prints the status transition of the cluster if it is one of the given names,
+// all clusters are printed if no name is given.
+func printClusterTransition(out io.Writer, obj *unstructured.Unstructured, names sets.Set[string], prev, phase string) {
+	if names.Len() > 0 && !names.Has(obj.GetName()) {
+		return
+	}
+	fmt.Fprintf(out, "%s\t%s/%s\t%s -> %s\n", util.TimeFormat(&metav1.Time{Time: time.Now()}),
+		obj.GetNamespace(), obj.GetName(), colorClusterPhase(prev), colorClusterPhase(phase))
+}
+
+func clusterPhase(obj *unstructured.Unstructured) string {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	return phase
+}
+
+// colorClusterPhase renders the cluster phase in color, green for Running, red for Failed
+// and Abnormal, and yellow for the other phases.
+func colorClusterPhase(phase string) string {
+	switch appsv1alpha1.ClusterPhase(phase) {
+	case "":
+		return "<none>"
+	case appsv1alpha1.RunningClusterPhase:
+		return printer.BoldGreen(phase)
+	case appsv1alpha1.FailedClusterPhase, appsv1alpha1.AbnormalClusterPhase:
+		return printer.BoldRed(phase)
+	default:
+		return printer.BoldYellow(phase)
+	}
+}
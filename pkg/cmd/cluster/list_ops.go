@@ -48,7 +48,10 @@ var (
 		kbcli cluster list-ops
 
 		# list all opsRequests of specified cluster
-		kbcli cluster list-ops mycluster`)
+		kbcli cluster list-ops mycluster
+
+		# list the restart and upgrade opsRequests of specified clusters
+		kbcli cluster list-ops --cluster mycluster,mycluster2 --type Restart,Upgrade`)
 
 	defaultDisplayPhase = []string{"pending", "creating", "running", "canceling", "failed"}
 )
@@ -58,6 +61,7 @@ type opsListOptions struct {
 	status         []string
 	opsType        []string
 	opsRequestName string
+	clusters       []string
 }
 
 func NewListOpsCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
@@ -72,7 +76,7 @@ func NewListOpsCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra
 		ValidArgsFunction: util.ResourceNameCompletionFunc(f, types.ClusterGVR()),
 		Run: func(cmd *cobra.Command, args []string) {
 			// build label selector for listing ops
-			o.LabelSelector = util.BuildLabelSelectorByNames(o.LabelSelector, args)
+			o.LabelSelector = util.BuildLabelSelectorByNames(o.LabelSelector, append(args, o.clusters...))
 			// args are the cluster names. we only use the label selector to get ops, so resources names
 			// are not needed.
 			o.Names = nil
@@ -85,12 +89,14 @@ func NewListOpsCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra
 	cmd.Flags().StringSliceVar(&o.status, "status", defaultDisplayPhase, fmt.Sprintf("Options include all, %s. by default, outputs the %s OpsRequest.",
 		strings.Join(defaultDisplayPhase, ", "), strings.Join(defaultDisplayPhase, "/")))
 	cmd.Flags().StringVar(&o.opsRequestName, "name", "", "The OpsRequest name to get the details.")
+	cmd.Flags().StringSliceVar(&o.clusters, "cluster", nil, "The cluster names of the OpsRequest, same as specifying the cluster names as arguments")
+	util.CheckErr(cmd.RegisterFlagCompletionFunc("cluster", util.ResourceNameCompletionFunc(f, types.ClusterGVR())))
 	return cmd
 }
 
 func (o *opsListOptions) printOpsList() error {
-	// if format is JSON or YAML, use default printer to output the result.
-	if o.Format == printer.JSON || o.Format == printer.YAML {
+	// if format is JSON, YAML or template, use default printer to output the result.
+	if o.Format == printer.JSON || o.Format == printer.YAML || o.Format == printer.Template {
 		if o.opsRequestName != "" {
 			o.Names = []string{o.opsRequestName}
 		}
@@ -133,7 +139,7 @@ func (o *opsListOptions) printOpsList() error {
 		opsType := string(ops.Spec.Type)
 		if len(o.opsRequestName) != 0 {
 			if ops.Name == o.opsRequestName {
-				tblPrinter.AddRow(ops.Name, ops.GetNamespace(), opsType, ops.Spec.GetClusterName(), getComponentNameFromOps(ops), phase, ops.Status.Progress, util.TimeFormat(&ops.CreationTimestamp))
+				tblPrinter.AddRow(ops.Name, ops.GetNamespace(), opsType, ops.Spec.GetClusterName(), getComponentNameFromOps(ops), phase, formatOpsProgress(ops.Status.Progress), util.TimeFormat(&ops.CreationTimestamp))
 			}
 			continue
 		}
@@ -145,7 +151,7 @@ func (o *opsListOptions) printOpsList() error {
 		if len(o.opsType) != 0 && !o.containsIgnoreCase(o.opsType, opsType) {
 			continue
 		}
-		tblPrinter.AddRow(ops.Name, ops.GetNamespace(), opsType, ops.Spec.GetClusterName(), getComponentNameFromOps(ops), phase, ops.Status.Progress, util.TimeFormat(&ops.CreationTimestamp))
+		tblPrinter.AddRow(ops.Name, ops.GetNamespace(), opsType, ops.Spec.GetClusterName(), getComponentNameFromOps(ops), phase, formatOpsProgress(ops.Status.Progress), util.TimeFormat(&ops.CreationTimestamp))
 	}
 	if tblPrinter.Tbl.Length() != 0 {
 		tblPrinter.Print()
@@ -159,6 +165,16 @@ func (o *opsListOptions) printOpsList() error {
 	return nil
 }
 
+// formatOpsProgress formats the progress of the OpsRequest in the form of "completed/total",
+// "-" is returned if the progress is unknown.
+func formatOpsProgress(progress string) string {
+	var completed, total int
+	if _, err := fmt.Sscanf(progress, "%d/%d", &completed, &total); err != nil || total <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d/%d", completed, total)
+}
+
 func getComponentNameFromOps(ops *appsv1alpha1.OpsRequest) string {
 	components := make([]string, 0)
 	opsSpec := ops.Spec
@@ -195,4 +195,19 @@ var _ = Describe("Expose", func() {
 		Expect(clitesting.ContainExpectStrings(capturedOutput, "kbcli cluster list-ops --status all")).Should(BeTrue())
 	})
 
+	It("format ops progress", func() {
+		Expect(formatOpsProgress("2/3")).Should(Equal("2/3"))
+		Expect(formatOpsProgress("-/-")).Should(Equal("-"))
+		Expect(formatOpsProgress("")).Should(Equal("-"))
+		Expect(formatOpsProgress("1/0")).Should(Equal("-"))
+	})
+
+	It("list ops of specified clusters", func() {
+		cmd := NewListOpsCmd(tf, streams)
+		Expect(cmd.Flags().Set("cluster", "mycluster")).Should(Succeed())
+		initOpsRequests()
+		cmd.Run(cmd, []string{"mycluster2"})
+		Expect(cmd.Flag("selector").Value.String()).Should(ContainSubstring("(mycluster2,mycluster)"))
+	})
+
 })
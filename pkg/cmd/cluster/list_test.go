@@ -21,6 +21,7 @@ package cluster
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"strings"
 
@@ -28,8 +29,11 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 	"k8s.io/cli-runtime/pkg/resource"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -37,6 +41,7 @@ import (
 	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
 
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
 
 	"github.com/apecloud/kbcli/pkg/cluster"
 	"github.com/apecloud/kbcli/pkg/testing"
@@ -121,6 +126,24 @@ var _ = Describe("list", func() {
 		Expect(out.String()).Should(ContainSubstring(string(appsv1alpha1.AbnormalClusterPhase)))
 	})
 
+	It("list with cluster definition", func() {
+		var labelSelector string
+		tf.UnstructuredClient = &clientfake.RESTClient{
+			GroupVersion:         schema.GroupVersion{Group: types.AppsAPIGroup, Version: types.AppsAPIVersion},
+			NegotiatedSerializer: resource.UnstructuredPlusDefaultContentConfig().NegotiatedSerializer,
+			Client: clientfake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+				labelSelector = req.URL.Query().Get("labelSelector")
+				return &http.Response{StatusCode: http.StatusOK, Header: cmdtesting.DefaultHeader(), Body: cmdtesting.ObjBody(scheme.Codecs.LegacyCodec(scheme.Scheme.PrioritizedVersionsAllGroups()...), &appsv1alpha1.ClusterList{})}, nil
+			}),
+		}
+		tf.Client = tf.UnstructuredClient
+
+		cmd := NewListCmd(tf, streams)
+		Expect(cmd.Flags().Set("cluster-definition", testing.ClusterDefName)).Should(Succeed())
+		cmd.Run(cmd, []string{})
+		Expect(labelSelector).Should(Equal(constant.ClusterDefLabelKey + " in (" + testing.ClusterDefName + ")"))
+	})
+
 	It("list instances", func() {
 		cmd := NewListInstancesCmd(tf, streams)
 		Expect(cmd).ShouldNot(BeNil())
@@ -154,6 +177,47 @@ var _ = Describe("list", func() {
 		Expect(out.String()).Should(ContainSubstring(testing.ClusterVersionName))
 	})
 
+	It("print cluster status transitions", func() {
+		toUnstructured := func(c *appsv1alpha1.Cluster) *unstructured.Unstructured {
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(c)
+			Expect(err).ShouldNot(HaveOccurred())
+			return &unstructured.Unstructured{Object: obj}
+		}
+		cls := testing.FakeCluster(clusterName, namespace)
+		cls.UID = "uid1"
+		cls.Status.Phase = appsv1alpha1.RunningClusterPhase
+		phases := map[string]string{string(cls.UID): string(cls.Status.Phase)}
+
+		w := watch.NewFakeWithChanSize(3, false)
+		cls.Status.Phase = appsv1alpha1.UpdatingClusterPhase
+		w.Modify(toUnstructured(cls))
+		// the status is not changed
+		w.Modify(toUnstructured(cls))
+		cls.Status.Phase = appsv1alpha1.FailedClusterPhase
+		cls.ResourceVersion = "2"
+		w.Modify(toUnstructured(cls))
+		w.Stop()
+
+		resourceVersion := ""
+		Expect(printClusterTransitions(context.Background(), out, w, sets.New[string](), phases, &resourceVersion)).Should(Succeed())
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		Expect(lines).Should(HaveLen(2))
+		Expect(lines[0]).Should(ContainSubstring(namespace + "/" + clusterName + "\tRunning -> Updating"))
+		Expect(lines[1]).Should(ContainSubstring("Updating -> Failed"))
+		Expect(phases[string(cls.UID)]).Should(Equal(string(appsv1alpha1.FailedClusterPhase)))
+		Expect(resourceVersion).Should(Equal("2"))
+
+		By("relist the clusters to catch up the missed transitions")
+		out.Reset()
+		cls.Status.Phase = appsv1alpha1.RunningClusterPhase
+		client := testing.FakeDynamicClient(cls).Resource(types.ClusterGVR()).Namespace(namespace)
+		phases["deleted-uid"] = string(appsv1alpha1.RunningClusterPhase)
+		_, err := syncClusterPhases(context.Background(), out, client, "", sets.New[string](), phases)
+		Expect(err).Should(Succeed())
+		Expect(out.String()).Should(ContainSubstring(namespace + "/" + clusterName + "\tFailed -> Running"))
+		Expect(phases).Should(Equal(map[string]string{string(cls.UID): string(appsv1alpha1.RunningClusterPhase)}))
+	})
+
 	It("output wide without args", func() {
 		cmd := NewListCmd(tf, streams)
 		Expect(cmd).ShouldNot(BeNil())
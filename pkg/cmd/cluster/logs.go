@@ -42,6 +42,7 @@ import (
 	"github.com/apecloud/kbcli/pkg/cluster"
 	"github.com/apecloud/kbcli/pkg/types"
 	"github.com/apecloud/kbcli/pkg/util"
+	"github.com/apecloud/kbcli/pkg/util/flags"
 )
 
 var (
@@ -58,6 +59,9 @@ var (
 		# Return snapshot logs from cluster mycluster with specific instance my-instance-0 (stdout)
 		kbcli cluster logs mycluster --instance my-instance-0
 
+		# Return snapshot logs from cluster mycluster with default instance of component mysql (stdout)
+		kbcli cluster logs mycluster --component mysql
+
 		# Return snapshot logs from cluster mycluster with specific instance my-instance-0 and specific container
         # my-container (stdout)
 		kbcli cluster logs mycluster --instance my-instance-0 -c my-container
@@ -78,9 +82,10 @@ var (
 
 // LogsOptions declares the arguments accepted by the logs command
 type LogsOptions struct {
-	clusterName string
-	fileType    string
-	filePath    string
+	clusterName   string
+	componentName string
+	fileType      string
+	filePath      string
 	*action.ExecOptions
 	logOptions cmdlogs.LogsOptions
 }
@@ -111,6 +116,7 @@ func NewLogsCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Co
 
 func (o *LogsOptions) addFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&o.PodName, "instance", "i", "", "Instance name.")
+	flags.AddComponentFlag(o.Factory, cmd, &o.componentName, "Component name. If specified, the default instance of the component is used when --instance is not specified.")
 	cmd.Flags().StringVarP(&o.logOptions.Container, "container", "c", "", "Container name.")
 	cmd.Flags().BoolVarP(&o.logOptions.Follow, "follow", "f", false, "Specify if the logs should be streamed.")
 	cmd.Flags().Int64Var(&o.logOptions.Tail, "tail", -1, "Lines of recent log file to display. Defaults to -1 for showing all log lines.")
@@ -145,9 +151,9 @@ func (o *LogsOptions) complete(args []string) error {
 	if len(args) > 0 {
 		o.clusterName = args[0]
 	}
-	// podName not set, find the default pod of cluster
+	// podName not set, find the default pod of cluster or the specified component
 	if len(o.PodName) == 0 {
-		infos := cluster.GetSimpleInstanceInfos(o.Dynamic, o.clusterName, o.Namespace)
+		infos := cluster.GetSimpleInstanceInfosForComponent(o.Dynamic, o.clusterName, o.componentName, o.Namespace)
 		if len(infos) == 0 || infos[0].Name == ComponentStatusDefaultPodName {
 			return fmt.Errorf("failed to find the default instance, please check cluster status")
 		}
@@ -123,6 +123,7 @@ var _ = Describe("logs", func() {
 		Expect(cmd).ShouldNot(BeNil())
 		Expect(cmd.Use).ShouldNot(BeNil())
 		Expect(cmd.Example).ShouldNot(BeNil())
+		Expect(cmd.Flags().Lookup("component")).ShouldNot(BeNil())
 
 		// Complete without args
 		Expect(l.complete([]string{})).Should(MatchError("cluster name or instance name should be specified"))
@@ -23,7 +23,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"github.com/apecloud/kubeblocks/pkg/common"
 	jsonpatch "github.com/evanphx/json-patch"
@@ -39,7 +41,10 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	apitypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/util/templates"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -50,6 +55,7 @@ import (
 	"github.com/apecloud/kbcli/pkg/action"
 	"github.com/apecloud/kbcli/pkg/cluster"
 	"github.com/apecloud/kbcli/pkg/printer"
+	"github.com/apecloud/kbcli/pkg/spinner"
 	"github.com/apecloud/kbcli/pkg/types"
 	"github.com/apecloud/kbcli/pkg/util"
 	"github.com/apecloud/kbcli/pkg/util/flags"
@@ -68,6 +74,13 @@ type OperationsOptions struct {
 	TTLSecondsAfterSucceed int      `json:"ttlSecondsAfterSucceed"`
 	Force                  bool     `json:"force"`
 
+	// Wait for the OpsRequest to complete
+	Wait    bool          `json:"-"`
+	Timeout time.Duration `json:"-"`
+
+	// Ctx is the context of the requests sent by the validation, usually the context of the command
+	Ctx context.Context `json:"-"`
+
 	// OpsType operation type
 	OpsType appsv1alpha1.OpsType `json:"type"`
 
@@ -157,11 +170,80 @@ func (o *OperationsOptions) addCommonFlags(cmd *cobra.Command, f cmdutil.Factory
 	cmd.Flags().IntVar(&o.TTLSecondsAfterSucceed, "ttlSecondsAfterSucceed", 0, "Time to live after the OpsRequest succeed")
 	cmd.Flags().StringVar(&o.DryRun, "dry-run", "none", `Must be "client", or "server". If with client strategy, only print the object that would be sent, and no data is actually sent. If with server strategy, submit the server-side request, but no data is persistent.`)
 	cmd.Flags().Lookup("dry-run").NoOptDefVal = "unchanged"
+	o.AddAuditLogFlag(cmd, false)
 	if o.HasComponentNamesFlag {
 		flags.AddComponentsFlag(f, cmd, &o.ComponentNames, "Component names to this operations")
 	}
 }
 
+// addWaitFlags adds flags for waiting the OpsRequest to complete
+func (o *OperationsOptions) addWaitFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.Wait, "wait", false, "Wait for the OpsRequest to complete")
+	cmd.Flags().DurationVar(&o.Timeout, "timeout", 30*time.Minute, "Time to wait for the OpsRequest to complete, only valid if --wait is true, such as --timeout=10m")
+}
+
+// WaitOpsRequest waits for the created OpsRequest to complete if --wait is specified
+func (o *OperationsOptions) WaitOpsRequest() error {
+	if !o.Wait {
+		return nil
+	}
+	if dryRun, err := o.GetDryRunStrategy(); err != nil || dryRun != action.DryRunNone {
+		return err
+	}
+	return waitOpsRequest(o.Dynamic, o.Out, o.Namespace, o.Name, o.Timeout)
+}
+
+// waitOpsRequest waits for the OpsRequest to succeed and prints its progress,
+// it returns an error if the OpsRequest failed, was cancelled or timed out.
+func waitOpsRequest(dynamic dynamic.Interface, out io.Writer, namespace, name string, timeout time.Duration) error {
+	return pollOpsRequest(dynamic, out, namespace, name, fmt.Sprintf("Wait for OpsRequest %s to complete", name), timeout,
+		func(phase appsv1alpha1.OpsPhase) (bool, error) {
+			switch phase {
+			case appsv1alpha1.OpsSucceedPhase:
+				return true, nil
+			case appsv1alpha1.OpsFailedPhase, appsv1alpha1.OpsCancelledPhase:
+				return false, fmt.Errorf("OpsRequest %s is %s, run \"kbcli cluster describe-ops %s -n %s\" to view the details", name, phase, name, namespace)
+			}
+			return false, nil
+		})
+}
+
+// waitOpsRequestCancelled waits for the OpsRequest to be cancelled, it returns an error if
+// the OpsRequest completed before it was cancelled or timed out.
+func waitOpsRequestCancelled(dynamic dynamic.Interface, out io.Writer, namespace, name string, timeout time.Duration) error {
+	return pollOpsRequest(dynamic, out, namespace, name, fmt.Sprintf("Wait for OpsRequest %s to be cancelled", name), timeout,
+		func(phase appsv1alpha1.OpsPhase) (bool, error) {
+			switch phase {
+			case appsv1alpha1.OpsCancelledPhase:
+				return true, nil
+			case appsv1alpha1.OpsFailedPhase, appsv1alpha1.OpsSucceedPhase:
+				return false, fmt.Errorf("OpsRequest %s is %s before it was cancelled, run \"kbcli cluster describe-ops %s -n %s\" to view the details", name, phase, name, namespace)
+			}
+			return false, nil
+		})
+}
+
+// pollOpsRequest polls the OpsRequest phase and shows its progress until the done function returns true or an error.
+func pollOpsRequest(dynamic dynamic.Interface, out io.Writer, namespace, name, header string, timeout time.Duration,
+	done func(phase appsv1alpha1.OpsPhase) (bool, error)) error {
+	s := spinner.New(out, spinner.WithMessage(fmt.Sprintf("%-50s", header)))
+	conditionFunc := func(_ context.Context) (bool, error) {
+		opsRequest := &appsv1alpha1.OpsRequest{}
+		if err := util.GetK8SClientObject(dynamic, opsRequest, types.OpsGVR(), namespace, name); err != nil {
+			return false, err
+		}
+		phase := opsRequest.Status.Phase
+		s.SetMessage(fmt.Sprintf("%-50s", fmt.Sprintf("%s, phase: %s, progress: %s", header, phase, util.CheckEmpty(opsRequest.Status.Progress))))
+		return done(phase)
+	}
+	if err := wait.PollUntilContextTimeout(context.Background(), 2*time.Second, timeout, true, conditionFunc); err != nil {
+		s.Fail()
+		return err
+	}
+	s.Success()
+	return nil
+}
+
 // CompleteRestartOps restarts all components of the cluster
 // we should set all component names to ComponentNames flag.
 func (o *OperationsOptions) CompleteRestartOps() error {
@@ -191,19 +273,37 @@ func (o *OperationsOptions) CompleteComponentsFlag() error {
 	if o.Name == "" {
 		return makeMissingClusterNameErr()
 	}
-	if len(o.ComponentNames) != 0 {
-		return nil
-	}
 	clusterObj, err := cluster.GetClusterByName(o.Dynamic, o.Name, o.Namespace)
 	if err != nil {
 		return err
 	}
+	if len(o.ComponentNames) != 0 {
+		return validateComponentNames(clusterObj, o.ComponentNames)
+	}
 	if len(clusterObj.Spec.ComponentSpecs) == 1 {
 		o.ComponentNames = []string{clusterObj.Spec.ComponentSpecs[0].Name}
 	}
 	return nil
 }
 
+// validateComponentNames checks that the specified components exist in the cluster before any
+// OpsRequest is created, and lists the valid component names if not.
+func validateComponentNames(clusterObj *appsv1alpha1.Cluster, names []string) error {
+	var validNames []string
+	for _, comp := range clusterObj.Spec.ComponentSpecs {
+		validNames = append(validNames, comp.Name)
+	}
+	for _, sharding := range clusterObj.Spec.ShardingSpecs {
+		validNames = append(validNames, sharding.Name)
+	}
+	for _, name := range names {
+		if !slices.Contains(validNames, name) {
+			return fmt.Errorf("component %s not found in cluster %s, valid components: [%s]", name, clusterObj.Name, strings.Join(validNames, ", "))
+		}
+	}
+	return nil
+}
+
 func (o *OperationsOptions) CompletePromoteOps() error {
 	clusterObj, err := cluster.GetClusterByName(o.Dynamic, o.Name, o.Namespace)
 	if err != nil {
@@ -326,9 +426,13 @@ func (o *OperationsOptions) CompleteHaEnabled() {
 	}
 }
 
-func (o *OperationsOptions) validateUpgrade() error {
+func (o *OperationsOptions) validateUpgrade(cls *appsv1alpha1.Cluster) error {
 	if len(o.ClusterVersionRef) > 0 {
-		return nil
+		// make sure the target cluster version exists and belongs to the cluster definition of the cluster
+		if err := cluster.ValidateClusterVersion(o.Dynamic, cls.Spec.ClusterDefRef, o.ClusterVersionRef); err != nil {
+			return fmt.Errorf("%v, run \"kbcli clusterversion list --cluster-definition %s\" to show all available cluster versions", err, cls.Spec.ClusterDefRef)
+		}
+		return o.printUpgradeImages(cls)
 	}
 	if len(o.ComponentNames) > 0 {
 		return nil
@@ -336,6 +440,58 @@ func (o *OperationsOptions) validateUpgrade() error {
 	return fmt.Errorf("missing cluster-version or components")
 }
 
+// printUpgradeImages prints the container images of the components before and after upgrading
+func (o *OperationsOptions) printUpgradeImages(cls *appsv1alpha1.Cluster) error {
+	getImages := func(cvName string) (map[string]string, error) {
+		images := map[string]string{}
+		if len(cvName) == 0 {
+			return images, nil
+		}
+		cv := &appsv1alpha1.ClusterVersion{}
+		if err := util.GetK8SClientObject(o.Dynamic, cv, types.ClusterVersionGVR(), "", cvName); err != nil {
+			return nil, err
+		}
+		for _, compVersion := range cv.Spec.ComponentVersions {
+			for _, c := range compVersion.VersionsCtx.Containers {
+				images[compVersion.ComponentDefRef+"/"+c.Name] = c.Image
+			}
+		}
+		return images, nil
+	}
+	currentImages, err := getImages(cls.Spec.ClusterVersionRef)
+	if err != nil {
+		return err
+	}
+	targetImages, err := getImages(o.ClusterVersionRef)
+	if err != nil {
+		return err
+	}
+
+	tbl := printer.NewTablePrinter(o.Out)
+	tbl.SetHeader("COMPONENT", "CONTAINER", "CURRENT-IMAGE", "TARGET-IMAGE")
+	for _, comp := range cls.Spec.ComponentSpecs {
+		var keys []string
+		for k := range targetImages {
+			if strings.HasPrefix(k, comp.ComponentDefRef+"/") {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			if currentImages[k] == targetImages[k] {
+				continue
+			}
+			tbl.AddRow(comp.Name, strings.TrimPrefix(k, comp.ComponentDefRef+"/"), util.CheckEmpty(currentImages[k]), targetImages[k])
+		}
+	}
+	if tbl.Tbl.Length() == 0 {
+		fmt.Fprintf(o.Out, "No image changes for cluster %s to upgrade to %s\n", cls.Name, o.ClusterVersionRef)
+		return nil
+	}
+	tbl.Print()
+	return nil
+}
+
 func (o *OperationsOptions) validateVolumeExpansion() error {
 	if len(o.VCTNames) == 0 {
 		return fmt.Errorf("missing volume-claim-templates")
@@ -343,6 +499,10 @@ func (o *OperationsOptions) validateVolumeExpansion() error {
 	if len(o.Storage) == 0 {
 		return fmt.Errorf("missing storage")
 	}
+	targetStorage, err := resource.ParseQuantity(o.Storage)
+	if err != nil {
+		return fmt.Errorf("cannot parse '%v', %v", o.Storage, err)
+	}
 
 	for _, cName := range o.ComponentNames {
 		for _, vctName := range o.VCTNames {
@@ -352,7 +512,7 @@ func (o *OperationsOptions) validateVolumeExpansion() error {
 				constant.VolumeClaimTemplateNameLabelKey, vctName,
 			)
 			pvcs, err := o.Client.CoreV1().PersistentVolumeClaims(o.Namespace).List(context.Background(),
-				metav1.ListOptions{LabelSelector: labels, Limit: 1})
+				metav1.ListOptions{LabelSelector: labels})
 			if err != nil {
 				return err
 			}
@@ -362,10 +522,6 @@ func (o *OperationsOptions) validateVolumeExpansion() error {
 			pvc := pvcs.Items[0]
 			specStorage := pvc.Spec.Resources.Requests.Storage()
 			statusStorage := pvc.Status.Capacity.Storage()
-			targetStorage, err := resource.ParseQuantity(o.Storage)
-			if err != nil {
-				return fmt.Errorf("cannot parse '%v', %v", o.Storage, err)
-			}
 			// determine whether the opsRequest is a recovery action for volume expansion failure
 			if specStorage.Cmp(targetStorage) > 0 &&
 				statusStorage.Cmp(targetStorage) <= 0 {
@@ -373,6 +529,13 @@ func (o *OperationsOptions) validateVolumeExpansion() error {
 				fmt.Fprintln(o.Out, printer.BoldYellow("Warning: this opsRequest is a recovery action for volume expansion failure and will re-create the PersistentVolumeClaims when RECOVER_VOLUME_EXPANSION_FAILURE=false"))
 				break
 			}
+			// the volume can only be expanded in place, so the new size must exceed the current capacity of every PVC
+			for _, pvc := range pvcs.Items {
+				capacity := pvc.Status.Capacity.Storage()
+				if !capacity.IsZero() && targetStorage.Cmp(*capacity) <= 0 {
+					return fmt.Errorf("the storage %s must be greater than the current capacity %s of PersistentVolumeClaim %s", o.Storage, capacity.String(), pvc.Name)
+				}
+			}
 		}
 	}
 	return nil
@@ -399,6 +562,9 @@ func (o *OperationsOptions) validateVScale(cluster *appsv1alpha1.Cluster) error
 			}
 			requests[corev1.ResourceMemory] = memory
 		}
+		if err := o.validateLimitRanges(requests); err != nil {
+			return err
+		}
 		requests.DeepCopyInto(&comp.Resources.Requests)
 		requests.DeepCopyInto(&comp.Resources.Limits)
 		return nil
@@ -418,6 +584,43 @@ func (o *OperationsOptions) validateVScale(cluster *appsv1alpha1.Cluster) error
 	return nil
 }
 
+// validateLimitRanges checks the requested resources are within the container limits of LimitRanges in the namespace
+func (o *OperationsOptions) validateLimitRanges(requests corev1.ResourceList) error {
+	if o.Client == nil {
+		return nil
+	}
+	ctx := o.Ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	limitRanges, err := o.Client.CoreV1().LimitRanges(o.Namespace).List(ctx, metav1.ListOptions{})
+	if apierrors.IsForbidden(err) {
+		// the users who can create OpsRequests may not be allowed to list LimitRanges,
+		// the API server still rejects the pods which violate the LimitRanges
+		klog.V(1).Infof("skip checking the LimitRanges in namespace %s: %v", o.Namespace, err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, lr := range limitRanges.Items {
+		for _, item := range lr.Spec.Limits {
+			if item.Type != corev1.LimitTypeContainer {
+				continue
+			}
+			for name, quantity := range requests {
+				if maxQuantity, ok := item.Max[name]; ok && quantity.Cmp(maxQuantity) > 0 {
+					return fmt.Errorf("%s %s exceeds the maximum %s of LimitRange %s", name, quantity.String(), maxQuantity.String(), lr.Name)
+				}
+				if minQuantity, ok := item.Min[name]; ok && quantity.Cmp(minQuantity) < 0 {
+					return fmt.Errorf("%s %s is less than the minimum %s of LimitRange %s", name, quantity.String(), minQuantity.String(), lr.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // Validate command flags or args is legal
 func (o *OperationsOptions) Validate() error {
 	if o.Name == "" {
@@ -440,7 +643,7 @@ func (o *OperationsOptions) Validate() error {
 			return err
 		}
 	case appsv1alpha1.UpgradeType:
-		if err = o.validateUpgrade(); err != nil {
+		if err = o.validateUpgrade(cluster); err != nil {
 			return err
 		}
 	case appsv1alpha1.VerticalScalingType:
@@ -656,6 +859,9 @@ var restartExample = templates.Examples(`
 
 		# specified component to restart, separate with commas for multiple components
 		kbcli cluster restart mycluster --components=mysql
+
+		# restart all components and wait for the restart to complete
+		kbcli cluster restart mycluster --wait --timeout=10m
 `)
 
 // NewRestartCmd creates a restart command
@@ -665,6 +871,7 @@ func NewRestartCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra
 		Use:               "restart NAME",
 		Short:             "Restart the specified components in the cluster.",
 		Example:           restartExample,
+		Args:              cobra.ExactArgs(1),
 		ValidArgsFunction: util.ResourceNameCompletionFunc(f, types.ClusterGVR()),
 		Run: func(cmd *cobra.Command, args []string) {
 			o.Args = args
@@ -673,9 +880,11 @@ func NewRestartCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra
 			cmdutil.CheckErr(o.CompleteRestartOps())
 			cmdutil.CheckErr(o.Validate())
 			cmdutil.CheckErr(o.Run())
+			cmdutil.CheckErr(o.WaitOpsRequest())
 		},
 	}
 	o.addCommonFlags(cmd, f)
+	o.addWaitFlags(cmd)
 	cmd.Flags().BoolVar(&o.AutoApprove, "auto-approve", false, "Skip interactive approval before restarting the cluster")
 	return cmd
 }
@@ -683,6 +892,9 @@ func NewRestartCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra
 var upgradeExample = templates.Examples(`
 		# upgrade the cluster to the target version
 		kbcli cluster upgrade mycluster --cluster-version=ac-mysql-8.0.30
+
+		# upgrade the cluster to the target version and wait for the upgrade to complete
+		kbcli cluster upgrade mycluster --cluster-version=ac-mysql-8.0.30 --wait
 `)
 
 // NewUpgradeCmd creates an upgrade command
@@ -701,9 +913,11 @@ func NewUpgradeCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra
 			cmdutil.CheckErr(o.Complete())
 			cmdutil.CheckErr(o.Validate())
 			cmdutil.CheckErr(o.Run())
+			cmdutil.CheckErr(o.WaitOpsRequest())
 		},
 	}
 	o.addCommonFlags(cmd, f)
+	o.addWaitFlags(cmd)
 	cmd.Flags().StringVar(&o.ClusterVersionRef, "cluster-version", "", "Referring to the ClusterVersion CR(deprecated)")
 	cmd.Flags().StringVar(&o.ComponentDefinitionName, compDefFlag, "nil", "Referring to the ComponentDefinition")
 	cmd.Flags().StringVar(&o.ServiceVersion, serviceVersionFlag, "nil", "Referring to the serviceVersion that is provided by ComponentDefinition and ComponentVersion")
@@ -727,14 +941,17 @@ func NewVerticalScalingCmd(f cmdutil.Factory, streams genericiooptions.IOStreams
 		ValidArgsFunction: util.ResourceNameCompletionFunc(f, types.ClusterGVR()),
 		Run: func(cmd *cobra.Command, args []string) {
 			o.Args = args
+			o.Ctx = cmd.Context()
 			cmdutil.BehaviorOnFatal(printer.FatalWithRedColor)
 			cmdutil.CheckErr(o.Complete())
 			cmdutil.CheckErr(o.CompleteComponentsFlag())
 			cmdutil.CheckErr(o.Validate())
 			cmdutil.CheckErr(o.Run())
+			cmdutil.CheckErr(o.WaitOpsRequest())
 		},
 	}
 	o.addCommonFlags(cmd, f)
+	o.addWaitFlags(cmd)
 	cmd.Flags().StringVar(&o.CPU, "cpu", "", "Request and limit size of component cpu")
 	cmd.Flags().StringVar(&o.Memory, "memory", "", "Request and limit size of component memory")
 	cmd.Flags().BoolVar(&o.AutoApprove, "auto-approve", false, "Skip interactive approval before vertically scaling the cluster")
@@ -762,10 +979,12 @@ func NewHorizontalScalingCmd(f cmdutil.Factory, streams genericiooptions.IOStrea
 			cmdutil.CheckErr(o.CompleteComponentsFlag())
 			cmdutil.CheckErr(o.Validate())
 			cmdutil.CheckErr(o.Run())
+			cmdutil.CheckErr(o.WaitOpsRequest())
 		},
 	}
 
 	o.addCommonFlags(cmd, f)
+	o.addWaitFlags(cmd)
 	cmd.Flags().IntVar(&o.Replicas, "replicas", 0, "Replicas with the specified components")
 	cmd.Flags().BoolVar(&o.AutoApprove, "auto-approve", false, "Skip interactive approval before horizontally scaling the cluster")
 	_ = cmd.MarkFlagRequired("replicas")
@@ -776,6 +995,9 @@ func NewHorizontalScalingCmd(f cmdutil.Factory, streams genericiooptions.IOStrea
 var volumeExpansionExample = templates.Examples(`
 		# restart specifies the component, separate with commas for multiple components
 		kbcli cluster volume-expand mycluster --components=mysql --volume-claim-templates=data --storage=10Gi
+
+		# expand the volume and wait for the expansion to complete
+		kbcli cluster volume-expand mycluster --components=mysql --volume-claim-templates=data --storage=10Gi --wait
 `)
 
 // NewVolumeExpansionCmd creates a volume expanding command
@@ -793,9 +1015,11 @@ func NewVolumeExpansionCmd(f cmdutil.Factory, streams genericiooptions.IOStreams
 			cmdutil.CheckErr(o.CompleteComponentsFlag())
 			cmdutil.CheckErr(o.Validate())
 			cmdutil.CheckErr(o.Run())
+			cmdutil.CheckErr(o.WaitOpsRequest())
 		},
 	}
 	o.addCommonFlags(cmd, f)
+	o.addWaitFlags(cmd)
 	cmd.Flags().StringSliceVarP(&o.VCTNames, "volume-claim-templates", "t", nil, "VolumeClaimTemplate names in components (required)")
 	cmd.Flags().StringVar(&o.Storage, "storage", "", "Volume storage size (required)")
 	cmd.Flags().BoolVar(&o.AutoApprove, "auto-approve", false, "Skip interactive approval before expanding the cluster volume")
@@ -907,6 +1131,9 @@ func NewStartCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.C
 var cancelExample = templates.Examples(`
 		# cancel the opsRequest which is not completed.
 		kbcli cluster cancel-ops <opsRequestName>
+
+		# cancel the opsRequest and wait for it to be cancelled
+		kbcli cluster cancel-ops <opsRequestName> --wait
 `)
 
 func cancelOps(o *OperationsOptions) error {
@@ -948,6 +1175,9 @@ func cancelOps(o *OperationsOptions) error {
 		opsRequest.Name, apitypes.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
 		return err
 	}
+	if o.Wait {
+		return waitOpsRequestCancelled(o.Dynamic, o.Out, opsRequest.Namespace, opsRequest.Name, o.Timeout)
+	}
 	fmt.Fprintf(o.Out, "start to cancel opsRequest \"%s\", you can view the progress:\n\tkbcli cluster list-ops --name %s\n", o.Name, o.Name)
 	return nil
 }
@@ -967,6 +1197,8 @@ func NewCancelCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.
 		},
 	}
 	cmd.Flags().BoolVar(&o.AutoApprove, "auto-approve", false, "Skip interactive approval before cancel the opsRequest")
+	cmd.Flags().BoolVar(&o.Wait, "wait", false, "Wait for the OpsRequest to be cancelled")
+	cmd.Flags().DurationVar(&o.Timeout, "timeout", 30*time.Minute, "Time to wait for the OpsRequest to be cancelled, only valid if --wait is true, such as --timeout=10m")
 	return cmd
 }
 
@@ -979,6 +1211,9 @@ var promoteExample = templates.Examples(`
 
 		# If the cluster has multiple components, you need to specify a component, otherwise an error will be reported.
 	    kbcli cluster promote mycluster --component=mysql --instance mycluster-mysql-1
+
+		# Promote the instance and wait for the switchover to complete, the new primary or leader is printed after it completes.
+		kbcli cluster promote mycluster --component=mysql --instance mycluster-mysql-1 --wait
 `)
 
 // NewPromoteCmd creates a promote command
@@ -986,7 +1221,8 @@ func NewPromoteCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra
 	o := newBaseOperationsOptions(f, streams, appsv1alpha1.SwitchoverType, false)
 	cmd := &cobra.Command{
 		Use:               "promote NAME [--component=<comp-name>] [--instance <instance-name>]",
-		Short:             "Promote a non-primary or non-leader instance as the new primary or leader of the cluster",
+		Aliases:           []string{"switchover"},
+		Short:             "Promote a non-primary or non-leader instance as the new primary or leader of the cluster, switchover is an alias of it",
 		Example:           promoteExample,
 		ValidArgsFunction: util.ResourceNameCompletionFunc(f, types.ClusterGVR()),
 		Run: func(cmd *cobra.Command, args []string) {
@@ -996,6 +1232,8 @@ func NewPromoteCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra
 			cmdutil.CheckErr(o.CompleteComponentsFlag())
 			cmdutil.CheckErr(o.CompletePromoteOps())
 			cmdutil.CheckErr(o.Validate())
+			// the name is set to the OpsRequest name after it is created
+			clusterName := o.Name
 			if (o.LorryHAEnabled || o.CharacterType == oceanbase) && o.ExecPod != nil {
 				// lorryCli, err := lorryclient.NewK8sExecClientWithPod(nil, o.ExecPod)
 				// cmdutil.CheckErr(err)
@@ -1019,15 +1257,73 @@ func NewPromoteCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra
 			} else {
 				cmdutil.CheckErr(o.Run())
 			}
+			cmdutil.CheckErr(o.WaitOpsRequest())
+			if o.Wait {
+				cmdutil.CheckErr(o.printNewPrimary(clusterName))
+			}
 		},
 	}
 	flags.AddComponentFlag(f, cmd, &o.Component, "Specify the component name of the cluster, if the cluster has multiple components, you need to specify a component")
 	cmd.Flags().StringVar(&o.Instance, "instance", "", "Specify the instance name as the new primary or leader of the cluster, you can get the instance name by running \"kbcli cluster list-instances\"")
 	cmd.Flags().BoolVar(&o.AutoApprove, "auto-approve", false, "Skip interactive approval before promote the instance")
 	o.addCommonFlags(cmd, f)
+	o.addWaitFlags(cmd)
 	return cmd
 }
 
+// printNewPrimary prints the instance that holds the primary or leader role of the component after switchover.
+func (o *OperationsOptions) printNewPrimary(clusterName string) error {
+	if dryRun, err := o.GetDryRunStrategy(); err != nil || dryRun != action.DryRunNone {
+		return err
+	}
+	clusterObj, err := cluster.GetClusterByName(o.Dynamic, clusterName, o.Namespace)
+	if err != nil {
+		return err
+	}
+	primaryRoles, err := o.getPrimaryRoles(clusterObj)
+	if err != nil {
+		return err
+	}
+	selector := labels.SelectorFromSet(map[string]string{
+		constant.AppInstanceLabelKey:    clusterName,
+		constant.KBAppComponentLabelKey: o.Component,
+	})
+	podList, err := o.Client.CoreV1().Pods(o.Namespace).List(context.Background(), metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return err
+	}
+	for _, pod := range podList.Items {
+		if slices.Contains(primaryRoles, pod.Labels[constant.RoleLabelKey]) {
+			fmt.Fprintf(o.Out, "Switchover of component %s completed, the new %s is %s\n", o.Component, pod.Labels[constant.RoleLabelKey], pod.Name)
+			return nil
+		}
+	}
+	return fmt.Errorf("component %s has no primary after switchover", o.Component)
+}
+
+// getPrimaryRoles returns the role names of the primary or leader instance of the component,
+// it is the serviceable and writable role if the component refers to a componentDefinition.
+func (o *OperationsOptions) getPrimaryRoles(clusterObj *appsv1alpha1.Cluster) ([]string, error) {
+	compSpec := clusterObj.Spec.GetComponentByName(o.Component)
+	if compSpec == nil {
+		return nil, fmt.Errorf("component %s not found in cluster %s", o.Component, clusterObj.Name)
+	}
+	if compSpec.ComponentDef == "" {
+		return []string{constant.Primary, constant.Leader}, nil
+	}
+	compDef := &appsv1alpha1.ComponentDefinition{}
+	if err := util.GetK8SClientObject(o.Dynamic, compDef, types.CompDefGVR(), "", compSpec.ComponentDef); err != nil {
+		return nil, err
+	}
+	var roles []string
+	for _, role := range compDef.Spec.Roles {
+		if role.Serviceable && role.Writable {
+			roles = append(roles, role.Name)
+		}
+	}
+	return roles, nil
+}
+
 var customOpsExample = templates.Examples(`
         # custom ops cli format
         kbcli cluster custom-ops <opsDefName> --cluster <clusterName> <your params of this opsDef>
@@ -1063,6 +1359,7 @@ func NewCustomOpsCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cob
 		RunE: func(cmd *cobra.Command, args []string) error {
 			o.Args = args
 			cmdutil.BehaviorOnFatal(printer.FatalWithRedColor)
+			cmdutil.CheckErr(flags.ParseInheritedFlags(cmd, args))
 			cmdutil.CheckErr(o.init())
 			err := o.parseOpsDefinitionAndParams(cmd, args)
 			if errors.Is(err, pflag.ErrHelp) {
@@ -1300,5 +1597,6 @@ func NewRebuildInstanceCmd(f cmdutil.Factory, streams genericiooptions.IOStreams
 	cmd.Flags().StringSliceVar(&o.InstanceNames, "instance", nil, "instance which need to rebuild.")
 	cmd.Flags().StringSliceVar(&o.Nodes, "node", nil, "specified the target node which rebuilds the instance on the node otherwise will rebuild on a randon node. format: insName1=nodeName,insName2=nodeName")
 	cmd.Flags().StringArrayVar(&o.Env, "env", []string{}, "provide the necessary env for the 'Restore' operation from the backup. format: key1=value, key2=value")
+	util.RegisterBackupCompletionFunc(cmd, f)
 	return cmd
 }
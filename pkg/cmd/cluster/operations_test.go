@@ -23,16 +23,19 @@ import (
 	"bytes"
 	"fmt"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 	clientfake "k8s.io/client-go/rest/fake"
+	clienttesting "k8s.io/client-go/testing"
 	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
 
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
@@ -148,10 +151,26 @@ var _ = Describe("operations", func() {
 		o.OpsType = appsv1alpha1.UpgradeType
 		Expect(o.Validate()).To(MatchError("missing cluster-version or components"))
 
-		By("expect to validate success")
+		By("expect an error for the cluster version which does not exist")
 		o.ClusterVersionRef = "test-cluster-version"
+		Expect(o.Validate()).Should(MatchError(ContainSubstring("failed to find cluster version")))
+
+		By("expect to validate success")
+		cv := testing.FakeClusterVersion()
+		cv.Name = "test-cluster-version"
+		cv.Spec.ComponentVersions = []appsv1alpha1.ClusterComponentVersion{
+			{
+				ComponentDefRef: testing.ComponentDefName,
+				VersionsCtx: appsv1alpha1.VersionsContext{
+					Containers: []corev1.Container{{Name: "mysql", Image: "mysql:8.0.33"}},
+				},
+			},
+		}
+		o.Dynamic = testing.FakeDynamicClient(testing.FakeClusterDef(), testing.FakeClusterVersion(), cv,
+			testing.FakeCluster(clusterName, testing.Namespace))
 		in.Write([]byte(o.Name + "\n"))
 		Expect(o.Validate()).Should(Succeed())
+		Expect(o.Out.(*bytes.Buffer).String()).Should(ContainSubstring("mysql:8.0.33"))
 	})
 
 	It("VolumeExpand Ops", func() {
@@ -195,11 +214,21 @@ var _ = Describe("operations", func() {
 		o.VCTNames = []string{vctName}
 		Expect(o.Validate()).To(MatchError("missing storage"))
 
+		By("validate volumeExpansion when storage is not greater than the current capacity")
+		o.Storage = "512Mi"
+		Expect(o.Validate()).To(MatchError(ContainSubstring("must be greater than the current capacity 1Gi")))
+
 		By("validate recovery from volume expansion failure")
 		o.Storage = "2Gi"
 		Expect(o.Validate()).Should(Succeed())
 		Expect(o.Out.(*bytes.Buffer).String()).To(ContainSubstring("Warning: this opsRequest is a recovery action for volume expansion failure and will re-create the PersistentVolumeClaims when RECOVER_VOLUME_EXPANSION_FAILURE=false"))
 
+		By("validate recovery back to the bound size of the PersistentVolumeClaim")
+		o.Out.(*bytes.Buffer).Reset()
+		o.Storage = "1Gi"
+		Expect(o.Validate()).Should(Succeed())
+		Expect(o.Out.(*bytes.Buffer).String()).To(ContainSubstring("recovery action for volume expansion failure"))
+
 		By("validate passed")
 		o.Storage = "4Gi"
 		in.Write([]byte(o.Name + "\n"))
@@ -225,6 +254,37 @@ var _ = Describe("operations", func() {
 		o.Memory = "100MB"
 		in.Write([]byte(o.Name + "\n"))
 		Expect(o.Validate()).Should(HaveOccurred())
+
+		By("validate resource exceeding the LimitRange")
+		o.Client = testing.FakeClientSet(&corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-limit-range", Namespace: testing.Namespace},
+			Spec: corev1.LimitRangeSpec{
+				Limits: []corev1.LimitRangeItem{
+					{
+						Type: corev1.LimitTypeContainer,
+						Max:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+					},
+				},
+			},
+		})
+		o.CPU = "4"
+		o.Memory = "1Gi"
+		Expect(o.Validate()).Should(MatchError(ContainSubstring("exceeds the maximum 2 of LimitRange test-limit-range")))
+
+		By("validate resource within the LimitRange")
+		o.CPU = "1"
+		in.Write([]byte(o.Name + "\n"))
+		Expect(o.Validate()).Should(Succeed())
+
+		By("skip the LimitRange check if listing LimitRanges is forbidden")
+		client := testing.FakeClientSet()
+		client.PrependReactor("list", "limitranges", func(action clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewForbidden(corev1.Resource("limitranges"), "", fmt.Errorf("forbidden"))
+		})
+		o.Client = client
+		o.CPU = "4"
+		in.Write([]byte(o.Name + "\n"))
+		Expect(o.Validate()).Should(Succeed())
 	})
 
 	It("Hscale Ops", func() {
@@ -245,6 +305,10 @@ var _ = Describe("operations", func() {
 		o.ComponentNames = nil
 		Expect(o.CompleteComponentsFlag()).Should(Succeed())
 		Expect(o.ComponentNames).Should(BeEmpty())
+
+		By("expect to fail for the component not in cluster")
+		o.ComponentNames = []string{testing.ComponentName, "not-exist"}
+		Expect(o.CompleteComponentsFlag()).Should(MatchError(ContainSubstring("component not-exist not found in cluster %s, valid components: [%s", clusterName, testing.ComponentName)))
 	})
 
 	It("Restart ops", func() {
@@ -260,6 +324,7 @@ var _ = Describe("operations", func() {
 
 		By("test Restart command")
 		restartCmd := NewRestartCmd(tf, streams)
+		Expect(restartCmd.Args(restartCmd, []string{clusterName, clusterName + "2"})).Should(HaveOccurred())
 		_, _ = in.Write([]byte(clusterName + "\n"))
 		done := testing.Capture()
 		restartCmd.Run(restartCmd, []string{clusterName})
@@ -267,6 +332,28 @@ var _ = Describe("operations", func() {
 		Expect(testing.ContainExpectStrings(capturedOutput, "kbcli cluster describe-ops")).Should(BeTrue())
 	})
 
+	It("wait ops", func() {
+		tf.FakeDynamicClient = testing.FakeDynamicClient(generationOps(appsv1alpha1.RestartType, appsv1alpha1.OpsSucceedPhase),
+			generationOps(appsv1alpha1.RestartType, appsv1alpha1.OpsFailedPhase))
+		o := newBaseOperationsOptions(tf, streams, appsv1alpha1.RestartType, true)
+		o.Dynamic = tf.FakeDynamicClient
+		o.Namespace = testing.Namespace
+		o.Timeout = time.Minute
+
+		By("do nothing if --wait is not specified")
+		o.Name = "not-exist"
+		Expect(o.WaitOpsRequest()).Should(Succeed())
+
+		By("expect succeed for the succeed opsRequest")
+		o.Wait = true
+		o.Name = getOpsName(appsv1alpha1.RestartType, appsv1alpha1.OpsSucceedPhase)
+		Expect(o.WaitOpsRequest()).Should(Succeed())
+
+		By("expect an error for the failed opsRequest")
+		o.Name = getOpsName(appsv1alpha1.RestartType, appsv1alpha1.OpsFailedPhase)
+		Expect(o.WaitOpsRequest()).Should(MatchError(ContainSubstring("is Failed")))
+	})
+
 	It("cancel ops", func() {
 		By("init some opsRequests which are needed for canceling opsRequest")
 		completedPhases := []appsv1alpha1.OpsPhase{appsv1alpha1.OpsCancelledPhase, appsv1alpha1.OpsSucceedPhase, appsv1alpha1.OpsFailedPhase}
@@ -321,6 +408,18 @@ var _ = Describe("operations", func() {
 				Expect(cancelOps(o)).Should(Succeed())
 			}
 		}
+
+		By("wait for the opsRequest to be cancelled")
+		o.Timeout = time.Second
+		for _, phase := range []appsv1alpha1.OpsPhase{appsv1alpha1.OpsCancelledPhase, appsv1alpha1.OpsSucceedPhase} {
+			o.Name = getOpsName(appsv1alpha1.VerticalScalingType, phase)
+			err := waitOpsRequestCancelled(o.Dynamic, o.Out, o.Namespace, o.Name, o.Timeout)
+			if phase == appsv1alpha1.OpsCancelledPhase {
+				Expect(err).Should(Succeed())
+			} else {
+				Expect(err).Should(MatchError(ContainSubstring("before it was cancelled")))
+			}
+		}
 	})
 
 	It("Switchover ops base on cluster component definition", func() {
@@ -408,6 +507,22 @@ var _ = Describe("operations", func() {
 		Expect(testing.ContainExpectStrings(o.Validate().Error(), "is invalid")).Should(BeTrue())
 	})
 
+	It("Promote with switchover alias and prints the new primary", func() {
+		cmd := NewPromoteCmd(tf, streams)
+		Expect(cmd.Aliases).Should(ContainElement("switchover"))
+		Expect(cmd.Flags().Lookup("wait")).ShouldNot(BeNil())
+
+		pods := testing.FakePods(2, testing.Namespace, clusterName1)
+		o := initCommonOperationOps(appsv1alpha1.SwitchoverType, clusterName1, false, &pods.Items[0], &pods.Items[1])
+		o.Component = testing.ComponentName
+
+		By("print the instance holding the leader role")
+		out := &bytes.Buffer{}
+		o.Out = out
+		Expect(o.printNewPrimary(clusterName1)).Should(Succeed())
+		Expect(out.String()).Should(ContainSubstring(fmt.Sprintf("the new leader is %s-pod-0", clusterName1)))
+	})
+
 	It("Custom ops base on component definition", func() {
 		o := initCommonOperationOps(appsv1alpha1.CustomType, clusterNameWithCompDef, false)
 		customOperations := &CustomOperations{
@@ -0,0 +1,179 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+	utilexec "k8s.io/utils/exec"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+
+	"github.com/apecloud/kbcli/pkg/types"
+	"github.com/apecloud/kbcli/pkg/util"
+)
+
+// the exit codes of the status command
+const (
+	statusExitReady    = 0
+	statusExitPending  = 1
+	statusExitFailed   = 2
+	statusExitNotFound = 3
+	statusExitError    = 4
+)
+
+var (
+	statusLong = templates.LongDesc(`
+	Show the status of a cluster and exit with a code indicating the readiness of the cluster,
+	the exit code can be used by scripts to poll the readiness of a cluster:
+
+	  0  the cluster is Running and Ready
+	  1  the cluster is not ready yet, such as Creating, Updating, or Running but not Ready
+	  2  the cluster is Failed or Abnormal, or is Stopping, Stopped or Deleting
+	  3  the cluster is not found
+	  4  the status can not be checked because of an error, such as an invalid kubeconfig,
+	     an unreachable API server or no permission to get the cluster`)
+
+	statusExample = templates.Examples(`
+	# show the status of a cluster
+	kbcli cluster status mycluster
+
+	# wait until the cluster is ready, and stop waiting if the status can not be checked
+	until kbcli cluster status mycluster; do [ $? -eq 4 ] && break; sleep 5; done`)
+)
+
+type statusOptions struct {
+	factory   cmdutil.Factory
+	dynamic   dynamic.Interface
+	namespace string
+	name      string
+
+	genericiooptions.IOStreams
+}
+
+func NewStatusCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &statusOptions{factory: f, IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:               "status NAME",
+		Short:             "Show the status of a cluster and exit with a code indicating its readiness.",
+		Long:              statusLong,
+		Example:           statusExample,
+		ValidArgsFunction: util.ResourceNameCompletionFunc(f, types.ClusterGVR()),
+		Run: func(cmd *cobra.Command, args []string) {
+			checkStatusErr(o.complete(args))
+			code, err := o.run()
+			checkStatusErr(err)
+			if code != statusExitReady {
+				os.Exit(code)
+			}
+		},
+	}
+	return cmd
+}
+
+// checkStatusErr exits with statusExitError if there is an error, so that the error is not
+// taken as the cluster is not ready yet by the scripts polling the exit code.
+func checkStatusErr(err error) {
+	if err == nil {
+		return
+	}
+	util.CheckErr(utilexec.CodeExitError{Err: fmt.Errorf("error: %v", err), Code: statusExitError})
+}
+
+func (o *statusOptions) complete(args []string) error {
+	var err error
+	if len(args) != 1 {
+		return fmt.Errorf("only one cluster name should be specified")
+	}
+	o.name = args[0]
+	if o.dynamic, err = o.factory.DynamicClient(); err != nil {
+		return err
+	}
+	if o.namespace, _, err = o.factory.ToRawKubeConfigLoader().Namespace(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// run prints the status summary of the cluster and returns the exit code.
+func (o *statusOptions) run() (int, error) {
+	obj, err := o.dynamic.Resource(types.ClusterGVR()).Namespace(o.namespace).Get(context.TODO(), o.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		fmt.Fprintf(o.Out, "Cluster %s not found in namespace %s\n", o.name, o.namespace)
+		return statusExitNotFound, nil
+	}
+	if err != nil {
+		return statusExitError, err
+	}
+	cls := &appsv1alpha1.Cluster{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, cls); err != nil {
+		return statusExitError, err
+	}
+	printClusterStatus(o.Out, cls)
+	return clusterStatusExitCode(cls), nil
+}
+
+func printClusterStatus(out io.Writer, cls *appsv1alpha1.Cluster) {
+	phase := string(cls.Status.Phase)
+	if phase == "" {
+		phase = "<none>"
+	}
+	ready := "False"
+	if meta.IsStatusConditionTrue(cls.Status.Conditions, appsv1alpha1.ConditionTypeReady) {
+		ready = "True"
+	}
+	fmt.Fprintf(out, "Cluster %s in namespace %s is %s, Ready: %s\n", cls.Name, cls.Namespace, phase, ready)
+	for _, comp := range cls.Spec.ComponentSpecs {
+		compPhase := string(cls.Status.Components[comp.Name].Phase)
+		if compPhase == "" {
+			compPhase = "<none>"
+		}
+		fmt.Fprintf(out, "  component %s: %s\n", comp.Name, compPhase)
+	}
+}
+
+// clusterStatusExitCode returns the exit code according to the phase and the Ready condition of the cluster.
+func clusterStatusExitCode(cls *appsv1alpha1.Cluster) int {
+	switch cls.Status.Phase {
+	case appsv1alpha1.RunningClusterPhase:
+		if meta.IsStatusConditionTrue(cls.Status.Conditions, appsv1alpha1.ConditionTypeReady) {
+			return statusExitReady
+		}
+		return statusExitPending
+	case appsv1alpha1.FailedClusterPhase, appsv1alpha1.AbnormalClusterPhase,
+		appsv1alpha1.StoppingClusterPhase, appsv1alpha1.StoppedClusterPhase, appsv1alpha1.DeletingClusterPhase:
+		return statusExitFailed
+	default:
+		// the cluster is Creating or Updating, or its phase is not set yet
+		return statusExitPending
+	}
+}
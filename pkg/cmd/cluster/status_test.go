@@ -0,0 +1,117 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cluster
+
+import (
+	"bytes"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	clientfake "k8s.io/client-go/rest/fake"
+	clienttesting "k8s.io/client-go/testing"
+	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+
+	"github.com/apecloud/kbcli/pkg/testing"
+	"github.com/apecloud/kbcli/pkg/types"
+)
+
+var _ = Describe("status", func() {
+	var (
+		streams genericiooptions.IOStreams
+		out     *bytes.Buffer
+		tf      *cmdtesting.TestFactory
+	)
+
+	BeforeEach(func() {
+		streams, _, out, _ = genericiooptions.NewTestIOStreams()
+		tf = cmdtesting.NewTestFactory().WithNamespace(testing.Namespace)
+		tf.Client = &clientfake.RESTClient{}
+	})
+
+	AfterEach(func() {
+		tf.Cleanup()
+	})
+
+	It("status command", func() {
+		cmd := NewStatusCmd(tf, streams)
+		Expect(cmd).ShouldNot(BeNil())
+		o := &statusOptions{factory: tf, IOStreams: streams}
+		Expect(o.complete(nil)).Should(HaveOccurred())
+		Expect(o.complete([]string{testing.ClusterName})).Should(Succeed())
+	})
+
+	It("status exit code", func() {
+		cls := testing.FakeCluster(testing.ClusterName, testing.Namespace)
+		Expect(clusterStatusExitCode(cls)).Should(Equal(statusExitPending))
+
+		cls.Status.Conditions = []metav1.Condition{{Type: appsv1alpha1.ConditionTypeReady, Status: metav1.ConditionTrue}}
+		Expect(clusterStatusExitCode(cls)).Should(Equal(statusExitReady))
+
+		for _, phase := range []appsv1alpha1.ClusterPhase{appsv1alpha1.CreatingClusterPhase, appsv1alpha1.UpdatingClusterPhase, ""} {
+			cls.Status.Phase = phase
+			Expect(clusterStatusExitCode(cls)).Should(Equal(statusExitPending))
+		}
+		for _, phase := range []appsv1alpha1.ClusterPhase{appsv1alpha1.FailedClusterPhase, appsv1alpha1.AbnormalClusterPhase, appsv1alpha1.StoppedClusterPhase} {
+			cls.Status.Phase = phase
+			Expect(clusterStatusExitCode(cls)).Should(Equal(statusExitFailed))
+		}
+	})
+
+	It("run status", func() {
+		cls := testing.FakeCluster(testing.ClusterName, testing.Namespace)
+		cls.Status.Conditions = []metav1.Condition{{Type: appsv1alpha1.ConditionTypeReady, Status: metav1.ConditionTrue}}
+		o := &statusOptions{
+			dynamic:   testing.FakeDynamicClient(cls),
+			namespace: testing.Namespace,
+			name:      testing.ClusterName,
+			IOStreams: streams,
+		}
+		code, err := o.run()
+		Expect(err).Should(Succeed())
+		Expect(code).Should(Equal(statusExitReady))
+		Expect(out.String()).Should(ContainSubstring("is Running, Ready: True"))
+		Expect(out.String()).Should(ContainSubstring("component " + testing.ComponentName + ":"))
+
+		out.Reset()
+		o.name = "not-exist"
+		code, err = o.run()
+		Expect(err).Should(Succeed())
+		Expect(code).Should(Equal(statusExitNotFound))
+		Expect(out.String()).Should(ContainSubstring("not found"))
+
+		By("the error is not taken as the cluster is not ready")
+		fakeDynamic := testing.FakeDynamicClient(cls)
+		fakeDynamic.PrependReactor("get", "clusters", func(clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewForbidden(types.ClusterGVR().GroupResource(), testing.ClusterName, fmt.Errorf("no permission"))
+		})
+		o.dynamic = fakeDynamic
+		o.name = testing.ClusterName
+		code, err = o.run()
+		Expect(apierrors.IsForbidden(err)).Should(BeTrue())
+		Expect(code).Should(Equal(statusExitError))
+	})
+})
@@ -0,0 +1,228 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
+	clientset "k8s.io/client-go/kubernetes"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+
+	"github.com/apecloud/kbcli/pkg/cluster"
+	"github.com/apecloud/kbcli/pkg/types"
+	"github.com/apecloud/kbcli/pkg/util"
+)
+
+var topologyExample = templates.Examples(`
+	# show the topology of a cluster
+	kbcli cluster topology mycluster`)
+
+type topologyOptions struct {
+	factory   cmdutil.Factory
+	client    clientset.Interface
+	dynamic   dynamic.Interface
+	namespace string
+	name      string
+
+	genericiooptions.IOStreams
+}
+
+func NewTopologyCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &topologyOptions{factory: f, IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:               "topology NAME",
+		Short:             "Show the components of a cluster and the relationships of them.",
+		Long:              "Show the components of a cluster and the instances of them. If the cluster definition defines the provision order of the components in the cluster topology, the components are connected by arrows in that order.",
+		Example:           topologyExample,
+		ValidArgsFunction: util.ResourceNameCompletionFunc(f, types.ClusterGVR()),
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(args))
+			util.CheckErr(o.run())
+		},
+	}
+	return cmd
+}
+
+func (o *topologyOptions) complete(args []string) error {
+	var err error
+	if len(args) != 1 {
+		return fmt.Errorf("only one cluster name should be specified")
+	}
+	o.name = args[0]
+	if o.client, err = o.factory.KubernetesClientSet(); err != nil {
+		return err
+	}
+	if o.dynamic, err = o.factory.DynamicClient(); err != nil {
+		return err
+	}
+	if o.namespace, _, err = o.factory.ToRawKubeConfigLoader().Namespace(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *topologyOptions) run() error {
+	getter := cluster.ObjectsGetter{
+		Client:    o.client,
+		Dynamic:   o.dynamic,
+		Name:      o.name,
+		Namespace: o.namespace,
+		GetOptions: cluster.GetOptions{
+			WithClusterDef: cluster.Maybe,
+			WithPod:        cluster.Need,
+		},
+	}
+	objs, err := getter.Get()
+	if err != nil {
+		return err
+	}
+	renderTopology(o.Out, objs)
+	return nil
+}
+
+// renderTopology renders the components of the cluster and their instances as ASCII art, such as:
+//
+//	Cluster mycluster (ClusterDefinition: mysql, Topology: proxy, Status: Running)
+//
+//	+ mysql (replicas: 2, status: Running)
+//	|   - mycluster-mysql-0 [primary] Running
+//	|   - mycluster-mysql-1 [secondary] Running
+//	|
+//	v
+//	+ proxysql (replicas: 1, status: Running)
+//	    - mycluster-proxysql-0 Running
+func renderTopology(out io.Writer, objs *cluster.ClusterObjects) {
+	cls := objs.Cluster
+	cdName := cls.Spec.ClusterDefRef
+	if cdName == "" {
+		cdName = "-"
+	}
+	topology := topologyOfCluster(cls, objs.ClusterDef)
+	topologyName := "-"
+	if topology != nil {
+		topologyName = topology.Name
+	}
+	fmt.Fprintf(out, "Cluster %s (ClusterDefinition: %s, Topology: %s, Status: %s)\n\n", cls.Name, cdName, topologyName, cls.Status.Phase)
+
+	groups := componentGroups(cls, topology)
+	for i, group := range groups {
+		// the components in the following groups are connected by an arrow
+		prefix := "    "
+		if i < len(groups)-1 {
+			prefix = "|   "
+		}
+		for _, compSpec := range group {
+			fmt.Fprintf(out, "+ %s (replicas: %d, status: %s)\n", compSpec.Name, compSpec.Replicas, cls.Status.Components[compSpec.Name].Phase)
+			for _, pod := range componentPods(objs.Pods, compSpec.Name) {
+				role := ""
+				if r := pod.Labels[constant.RoleLabelKey]; r != "" {
+					role = fmt.Sprintf(" [%s]", r)
+				}
+				fmt.Fprintf(out, "%s- %s%s %s\n", prefix, pod.Name, role, pod.Status.Phase)
+			}
+		}
+		if i < len(groups)-1 {
+			fmt.Fprint(out, "|\nv\n")
+		}
+	}
+}
+
+// topologyOfCluster returns the topology used by the cluster, it is the topology specified
+// by the cluster or the default topology of the cluster definition.
+func topologyOfCluster(cls *appsv1alpha1.Cluster, cd *appsv1alpha1.ClusterDefinition) *appsv1alpha1.ClusterTopology {
+	if cd == nil {
+		return nil
+	}
+	for i, t := range cd.Spec.Topologies {
+		if (cls.Spec.Topology == "" && t.Default) || t.Name == cls.Spec.Topology {
+			return &cd.Spec.Topologies[i]
+		}
+	}
+	return nil
+}
+
+// componentGroups groups the components of the cluster by the provision order of the topology,
+// the components in a group are provisioned in parallel. All components are in one group if
+// the order is not defined, and the components not in the order are put into the last group.
+func componentGroups(cls *appsv1alpha1.Cluster, topology *appsv1alpha1.ClusterTopology) [][]appsv1alpha1.ClusterComponentSpec {
+	if len(cls.Spec.ComponentSpecs) == 0 {
+		return nil
+	}
+	if topology == nil || topology.Orders == nil || len(topology.Orders.Provision) == 0 {
+		return [][]appsv1alpha1.ClusterComponentSpec{cls.Spec.ComponentSpecs}
+	}
+	specs := make(map[string]appsv1alpha1.ClusterComponentSpec, len(cls.Spec.ComponentSpecs))
+	for _, compSpec := range cls.Spec.ComponentSpecs {
+		specs[compSpec.Name] = compSpec
+	}
+	var groups [][]appsv1alpha1.ClusterComponentSpec
+	grouped := map[string]bool{}
+	for _, names := range topology.Orders.Provision {
+		var group []appsv1alpha1.ClusterComponentSpec
+		for _, name := range strings.Split(names, ",") {
+			name = strings.TrimSpace(name)
+			if compSpec, ok := specs[name]; ok && !grouped[name] {
+				group = append(group, compSpec)
+				grouped[name] = true
+			}
+		}
+		if len(group) > 0 {
+			groups = append(groups, group)
+		}
+	}
+	var rest []appsv1alpha1.ClusterComponentSpec
+	for _, compSpec := range cls.Spec.ComponentSpecs {
+		if !grouped[compSpec.Name] {
+			rest = append(rest, compSpec)
+		}
+	}
+	if len(rest) > 0 {
+		groups = append(groups, rest)
+	}
+	return groups
+}
+
+// componentPods returns the pods of the component sorted by name
+func componentPods(pods *corev1.PodList, compName string) []corev1.Pod {
+	if pods == nil {
+		return nil
+	}
+	var result []corev1.Pod
+	for _, pod := range pods.Items {
+		if pod.Labels[constant.KBAppComponentLabelKey] == compName {
+			result = append(result, pod)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
@@ -0,0 +1,102 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cluster
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	clientfake "k8s.io/client-go/rest/fake"
+	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+
+	"github.com/apecloud/kbcli/pkg/cluster"
+	"github.com/apecloud/kbcli/pkg/testing"
+)
+
+var _ = Describe("topology", func() {
+	var (
+		streams genericiooptions.IOStreams
+		tf      *cmdtesting.TestFactory
+	)
+
+	BeforeEach(func() {
+		streams, _, _, _ = genericiooptions.NewTestIOStreams()
+		tf = cmdtesting.NewTestFactory().WithNamespace(testing.Namespace)
+		tf.Client = &clientfake.RESTClient{}
+	})
+
+	AfterEach(func() {
+		tf.Cleanup()
+	})
+
+	It("topology command", func() {
+		cmd := NewTopologyCmd(tf, streams)
+		Expect(cmd).ShouldNot(BeNil())
+		o := &topologyOptions{factory: tf, IOStreams: streams}
+		Expect(o.complete(nil)).Should(HaveOccurred())
+		Expect(o.complete([]string{testing.ClusterName})).Should(Succeed())
+	})
+
+	It("render topology", func() {
+		objs := &cluster.ClusterObjects{
+			Cluster: testing.FakeCluster(testing.ClusterName, testing.Namespace),
+			Pods:    testing.FakePods(2, testing.Namespace, testing.ClusterName),
+		}
+
+		By("render the components without the provision order")
+		out := &bytes.Buffer{}
+		renderTopology(out, objs)
+		Expect(out.String()).Should(HavePrefix("Cluster " + testing.ClusterName + " (ClusterDefinition: " + testing.ClusterDefName + ", Topology: -, Status: Running)"))
+		Expect(out.String()).Should(ContainSubstring("+ " + testing.ComponentName + " (replicas: 1"))
+		Expect(out.String()).Should(ContainSubstring("    - " + testing.ClusterName + "-pod-0 [leader] Running\n    - " + testing.ClusterName + "-pod-1 [follower] Running\n"))
+		Expect(out.String()).ShouldNot(ContainSubstring("v\n"))
+
+		By("render the components in the provision order of topology")
+		objs.ClusterDef = testing.FakeClusterDef()
+		objs.ClusterDef.Spec.Topologies = []appsv1alpha1.ClusterTopology{
+			{
+				Name:    "default",
+				Default: true,
+				Orders:  &appsv1alpha1.ClusterTopologyOrders{Provision: []string{testing.ComponentName + "-1", testing.ComponentName}},
+			},
+		}
+		out.Reset()
+		renderTopology(out, objs)
+		Expect(out.String()).Should(ContainSubstring("Topology: default"))
+		Expect(out.String()).Should(ContainSubstring("+ " + testing.ComponentName + "-1 (replicas: 1, status: )\n|\nv\n+ " + testing.ComponentName + " (replicas: 1"))
+	})
+
+	It("component groups", func() {
+		cls := testing.FakeCluster(testing.ClusterName, testing.Namespace)
+		Expect(componentGroups(cls, nil)).Should(HaveLen(1))
+
+		topology := &appsv1alpha1.ClusterTopology{
+			Orders: &appsv1alpha1.ClusterTopologyOrders{Provision: []string{"unknown", testing.ComponentName}},
+		}
+		groups := componentGroups(cls, topology)
+		Expect(groups).Should(HaveLen(2))
+		Expect(groups[0][0].Name).Should(Equal(testing.ComponentName))
+		Expect(groups[1][0].Name).Should(Equal(testing.ComponentName + "-1"))
+	})
+})
@@ -0,0 +1,107 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package completion
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/spf13/cobra"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var completionExample = templates.Examples(`
+	# Load the kbcli completion code for bash into the current shell
+	source <(kbcli completion bash)
+
+	# Write bash completion code to a file and source it from .bash_profile
+	kbcli completion bash > ~/.kube/kbcli_completion.bash.inc
+	printf "
+	# kbcli shell completion
+	source '$HOME/.kube/kbcli_completion.bash.inc'
+	" >> $HOME/.bash_profile
+	source $HOME/.bash_profile
+
+	# Load the kbcli completion code for zsh into the current shell
+	source <(kbcli completion zsh)
+
+	# Load the kbcli completion code for fish into the current shell
+	kbcli completion fish | source
+
+	# Load the kbcli completion code for powershell into the current shell
+	kbcli completion powershell | Out-String | Invoke-Expression`)
+
+var completionShells = map[string]func(out io.Writer, cmd *cobra.Command) error{
+	"bash":       runCompletionBash,
+	"zsh":        runCompletionZsh,
+	"fish":       runCompletionFish,
+	"powershell": runCompletionPwsh,
+}
+
+// NewCompletionCmd creates the completion command which outputs shell completion code.
+func NewCompletionCmd(out io.Writer) *cobra.Command {
+	var shells []string
+	for s := range completionShells {
+		shells = append(shells, s)
+	}
+	sort.Strings(shells)
+
+	cmd := &cobra.Command{
+		Use:                   "completion SHELL",
+		DisableFlagsInUseLine: true,
+		Short:                 "Output shell completion code for the specified shell (bash, zsh, fish, or powershell).",
+		Long: `Output shell completion code for the specified shell (bash, zsh, fish, or powershell).
+The shell code must be evaluated to provide interactive completion of kbcli commands,
+flags and resource names such as clusters, backups and namespaces.`,
+		Example:   completionExample,
+		ValidArgs: shells,
+		Args:      cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(runCompletion(out, cmd, args))
+		},
+	}
+	return cmd
+}
+
+func runCompletion(out io.Writer, cmd *cobra.Command, args []string) error {
+	run, found := completionShells[args[0]]
+	if !found {
+		return fmt.Errorf("unsupported shell type %q", args[0])
+	}
+	return run(out, cmd.Root())
+}
+
+func runCompletionBash(out io.Writer, kbcli *cobra.Command) error {
+	return kbcli.GenBashCompletionV2(out, true)
+}
+
+func runCompletionZsh(out io.Writer, kbcli *cobra.Command) error {
+	return kbcli.GenZshCompletion(out)
+}
+
+func runCompletionFish(out io.Writer, kbcli *cobra.Command) error {
+	return kbcli.GenFishCompletion(out, true)
+}
+
+func runCompletionPwsh(out io.Writer, kbcli *cobra.Command) error {
+	return kbcli.GenPowerShellCompletionWithDesc(out)
+}
@@ -0,0 +1,45 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package completion
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+)
+
+var _ = Describe("completion", func() {
+	It("generate completion code", func() {
+		out := &bytes.Buffer{}
+		root := &cobra.Command{Use: "kbcli"}
+		cmd := NewCompletionCmd(out)
+		root.AddCommand(cmd)
+		Expect(cmd.ValidArgs).Should(HaveLen(len(completionShells)))
+
+		for _, shell := range []string{"bash", "zsh", "fish", "powershell"} {
+			out.Reset()
+			Expect(runCompletion(out, cmd, []string{shell})).Should(Succeed())
+			Expect(out.String()).Should(ContainSubstring("kbcli"))
+		}
+		Expect(runCompletion(out, cmd, []string{"tcsh"})).Should(HaveOccurred())
+	})
+})
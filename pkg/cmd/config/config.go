@@ -0,0 +1,280 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/klog/v2"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/yaml"
+
+	"github.com/apecloud/kbcli/pkg/printer"
+	"github.com/apecloud/kbcli/pkg/util"
+)
+
+const (
+	configFileName    = "config.yaml"
+	currentContextKey = "current-context"
+	contextsKey       = "contexts"
+	noColorKey        = "no-color"
+)
+
+// NewConfigCmd creates the config command
+func NewConfigCmd(streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage kbcli contexts.",
+		Long: templates.LongDesc(`
+		Manage the kbcli contexts stored in $HOME/.kbcli/config.yaml, or $KBCLI_HOME/config.yaml if KBCLI_HOME is set.
+
+		A kbcli context holds the default values of flags which are used when the flags are not
+		specified in the command line, it is independent of the contexts in kubeconfig. The file
+		format looks like:
+
+		    current-context: dev
+		    contexts:
+		      dev:
+		        namespace: dev      # default --namespace
+		        output: json        # default --output of the commands that support it
+		        cluster: mycluster  # default --cluster of the commands that require it
+		    no-color: true          # default --no-color, applies to all contexts
+
+		The contexts are validated when the file is loaded: the namespace must be a valid namespace
+		name, the output must be one of table, json, yaml and wide, and the current context must exist.
+		An invalid file is ignored with a warning by the other commands, and it can be fixed by
+		"kbcli config set-context" and "kbcli config use-context".`),
+	}
+	cmd.AddCommand(
+		newSetContextCmd(streams),
+		newUseContextCmd(streams),
+	)
+	return cmd
+}
+
+// Config is the kbcli specific contexts stored in the kbcli config file, other settings
+// in the same file are kept untouched when the contexts are saved.
+type Config struct {
+	// CurrentContext is the name of the context used by default
+	CurrentContext string `json:"current-context,omitempty"`
+	// Contexts is a map of the named contexts
+	Contexts map[string]*ContextDefaults `json:"contexts,omitempty"`
+	// NoColor disables the colored output regardless of the current context, the same as --no-color
+	NoColor bool `json:"no-color,omitempty"`
+}
+
+// ContextDefaults holds the default values of the flags used by kbcli commands
+type ContextDefaults struct {
+	// Namespace is the default namespace, the same as --namespace
+	Namespace string `json:"namespace,omitempty"`
+	// Output is the default output format, the same as --output
+	Output string `json:"output,omitempty"`
+	// Cluster is the default KubeBlocks cluster for the commands which require a --cluster flag
+	Cluster string `json:"cluster,omitempty"`
+}
+
+// configFilePath returns the path of the kbcli config file
+func configFilePath() (string, error) {
+	home, err := util.GetCliHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, configFileName), nil
+}
+
+// LoadConfig loads and validates the contexts in the config file, an empty config
+// is returned if the file does not exist.
+func LoadConfig(path string) (*Config, error) {
+	c, err := readConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %v", path, err)
+	}
+	return c, nil
+}
+
+// readConfig reads the contexts in the config file without validating them, it is used
+// by the config commands so that an invalid config file can be repaired by them.
+func readConfig(path string) (*Config, error) {
+	c := &Config{}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = yaml.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %v", path, err)
+	}
+	return c, nil
+}
+
+// SaveConfig writes the contexts to the config file and keeps the other settings in it.
+func SaveConfig(path string, c *Config) error {
+	if err := c.validate(); err != nil {
+		return err
+	}
+	settings := map[string]interface{}{}
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err = yaml.Unmarshal(data, &settings); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %v", path, err)
+	}
+	if settings == nil {
+		settings = map[string]interface{}{}
+	}
+	delete(settings, currentContextKey)
+	delete(settings, contextsKey)
+	delete(settings, noColorKey)
+	if c.NoColor {
+		settings[noColorKey] = true
+	}
+	if c.CurrentContext != "" {
+		settings[currentContextKey] = c.CurrentContext
+	}
+	if len(c.Contexts) > 0 {
+		settings[contextsKey] = c.Contexts
+	}
+	if data, err = yaml.Marshal(settings); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+func (c *Config) validate() error {
+	for name, ctx := range c.Contexts {
+		if name == "" {
+			return fmt.Errorf("context name can not be empty")
+		}
+		if ctx == nil {
+			return fmt.Errorf("context %q is empty", name)
+		}
+		if ctx.Namespace != "" {
+			if errs := validation.IsDNS1123Label(ctx.Namespace); len(errs) > 0 {
+				return fmt.Errorf("invalid namespace %q of context %q: %v", ctx.Namespace, name, errs)
+			}
+		}
+		if ctx.Output != "" {
+			if _, err := printer.ParseFormat(ctx.Output); err != nil {
+				return fmt.Errorf("invalid output format %q of context %q, allowed values: %v", ctx.Output, name, printer.Formats())
+			}
+		}
+	}
+	if c.CurrentContext != "" && c.Contexts[c.CurrentContext] == nil {
+		return fmt.Errorf("current context %q does not exist", c.CurrentContext)
+	}
+	return nil
+}
+
+// ApplyCurrentContext sets the values of the current context and the global settings as
+// the defaults of the flags which are not specified explicitly in the command line. An
+// invalid config file is ignored with a warning, and the config commands are skipped so
+// that they can always be used to repair the file.
+func ApplyCurrentContext(cmd *cobra.Command) error {
+	if isConfigCmd(cmd) {
+		return nil
+	}
+	path, err := configFilePath()
+	if err != nil {
+		return err
+	}
+	c, err := LoadConfig(path)
+	if err != nil {
+		printer.Warning(cmd.ErrOrStderr(), "the kbcli contexts are ignored: %v, run \"kbcli config set-context\" to fix it\n", err)
+		return nil
+	}
+	if c.NoColor {
+		setFlagDefault(cmd.Flags(), noColorKey, "true")
+	}
+	ctx := c.Contexts[c.CurrentContext]
+	if ctx == nil {
+		return nil
+	}
+	// --namespace is a global flag, but --output and --cluster have different meanings
+	// between commands, only the ones defined by the command itself are set. --cluster
+	// is optional for most commands and changes their behavior if it is set, such as
+	// filtering the list results, so it is only set when the command requires it.
+	setFlagDefault(cmd.Flags(), "namespace", ctx.Namespace)
+	setFlagDefault(cmd.LocalFlags(), "output", ctx.Output)
+	setRequiredFlag(cmd.LocalFlags(), "cluster", ctx.Cluster)
+	return nil
+}
+
+// isConfigCmd checks if the command is the config command or one of its subcommands
+func isConfigCmd(cmd *cobra.Command) bool {
+	for c := cmd; c.HasParent(); c = c.Parent() {
+		if c.Name() == "config" && !c.Parent().HasParent() {
+			return true
+		}
+	}
+	return false
+}
+
+// setRequiredFlag sets the value of a required flag which is not specified, the flag
+// is marked as changed so that it passes the required flags check of cobra.
+func setRequiredFlag(flags *pflag.FlagSet, name string, value string) {
+	if value == "" {
+		return
+	}
+	flag := flags.Lookup(name)
+	if flag == nil || flag.Changed {
+		return
+	}
+	if required, ok := flag.Annotations[cobra.BashCompOneRequiredFlag]; !ok || len(required) == 0 || required[0] != "true" {
+		return
+	}
+	if err := flags.Set(name, value); err != nil {
+		klog.V(1).Infof("failed to set the value of flag --%s from context: %v", name, err)
+	}
+}
+
+func setFlagDefault(flags *pflag.FlagSet, name string, value string) {
+	if value == "" {
+		return
+	}
+	flag := flags.Lookup(name)
+	if flag == nil || flag.Changed {
+		return
+	}
+	if err := flag.Value.Set(value); err != nil {
+		klog.V(1).Infof("failed to set the default value of flag --%s from context: %v", name, err)
+	}
+}
+
+func contextNames(c *Config) []string {
+	var names []string
+	for name := range c.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,174 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/apecloud/kbcli/pkg/printer"
+	"github.com/apecloud/kbcli/pkg/types"
+)
+
+var _ = Describe("config", func() {
+	var (
+		streams    genericiooptions.IOStreams
+		errOut     *bytes.Buffer
+		configPath string
+	)
+
+	BeforeEach(func() {
+		streams, _, _, errOut = genericiooptions.NewTestIOStreams()
+		home := GinkgoT().TempDir()
+		GinkgoT().Setenv(types.CliHomeEnv, home)
+		configPath = filepath.Join(home, configFileName)
+		Expect(os.WriteFile(configPath, []byte("addon_index_url: https://example.com\n"), 0600)).Should(Succeed())
+	})
+
+	It("set and use context", func() {
+		cmd := NewConfigCmd(streams)
+		Expect(cmd).ShouldNot(BeNil())
+
+		By("set context")
+		setCmd := newSetContextCmd(streams)
+		Expect(setCmd.Flags().Set("namespace", "dev")).Should(Succeed())
+		Expect(setCmd.Flags().Set("output", "json")).Should(Succeed())
+		o := &setContextOptions{name: "dev", context: ContextDefaults{Namespace: "dev", Output: "json"}, IOStreams: streams}
+		Expect(o.run(setCmd)).Should(Succeed())
+
+		By("only update the specified fields")
+		setCmd = newSetContextCmd(streams)
+		Expect(setCmd.Flags().Set("cluster", "mycluster")).Should(Succeed())
+		o = &setContextOptions{name: "dev", context: ContextDefaults{Cluster: "mycluster"}, IOStreams: streams}
+		Expect(o.run(setCmd)).Should(Succeed())
+
+		c, err := LoadConfig(configPath)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(c.Contexts["dev"]).Should(Equal(&ContextDefaults{Namespace: "dev", Output: "json", Cluster: "mycluster"}))
+		Expect(c.CurrentContext).Should(BeEmpty())
+
+		By("use context")
+		Expect((&useContextOptions{name: "test", IOStreams: streams}).run()).Should(HaveOccurred())
+		Expect((&useContextOptions{name: "dev", IOStreams: streams}).run()).Should(Succeed())
+		c, err = LoadConfig(configPath)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(c.CurrentContext).Should(Equal("dev"))
+
+		By("other settings are kept")
+		data, err := os.ReadFile(configPath)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(data)).Should(ContainSubstring("addon_index_url"))
+
+		By("apply the current context")
+		var format printer.Format
+		var cluster string
+		listCmd := &cobra.Command{Use: "list"}
+		printer.AddOutputFlag(listCmd, &format)
+		listCmd.Flags().StringVar(&cluster, "cluster", "", "")
+		Expect(ApplyCurrentContext(listCmd)).Should(Succeed())
+		Expect(format).Should(Equal(printer.JSON))
+		Expect(cluster).Should(BeEmpty())
+
+		By("the cluster is only set to the commands which require it")
+		promoteCmd := &cobra.Command{Use: "promote"}
+		promoteCmd.Flags().StringVar(&cluster, "cluster", "", "")
+		Expect(promoteCmd.MarkFlagRequired("cluster")).Should(Succeed())
+		Expect(ApplyCurrentContext(promoteCmd)).Should(Succeed())
+		Expect(cluster).Should(Equal("mycluster"))
+		Expect(promoteCmd.ValidateRequiredFlags()).Should(Succeed())
+
+		By("the specified flags are not overridden")
+		Expect(listCmd.Flags().Set("output", "yaml")).Should(Succeed())
+		Expect(ApplyCurrentContext(listCmd)).Should(Succeed())
+		Expect(format).Should(Equal(printer.YAML))
+	})
+
+	It("apply the global settings", func() {
+		Expect(os.WriteFile(configPath, []byte("no-color: true\n"), 0600)).Should(Succeed())
+		var noColor bool
+		listCmd := &cobra.Command{Use: "list"}
+		listCmd.Flags().BoolVar(&noColor, noColorKey, false, "")
+		Expect(ApplyCurrentContext(listCmd)).Should(Succeed())
+		Expect(noColor).Should(BeTrue())
+
+		By("the global settings are kept when saving contexts")
+		o := &setContextOptions{name: "dev", context: ContextDefaults{Namespace: "dev"}, IOStreams: streams}
+		setCmd := newSetContextCmd(streams)
+		Expect(setCmd.Flags().Set("namespace", "dev")).Should(Succeed())
+		Expect(o.run(setCmd)).Should(Succeed())
+		c, err := LoadConfig(configPath)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(c.NoColor).Should(BeTrue())
+	})
+
+	It("ignore the invalid config file", func() {
+		Expect(os.WriteFile(configPath, []byte("current-context: dev\n"), 0600)).Should(Succeed())
+		var namespace string
+		listCmd := &cobra.Command{Use: "list"}
+		listCmd.Flags().StringVar(&namespace, "namespace", "", "")
+		listCmd.SetErr(errOut)
+		Expect(ApplyCurrentContext(listCmd)).Should(Succeed())
+		Expect(errOut.String()).Should(ContainSubstring("does not exist"))
+
+		By("the config commands are skipped")
+		errOut.Reset()
+		root := &cobra.Command{Use: "kbcli"}
+		configCmd := NewConfigCmd(streams)
+		root.AddCommand(configCmd)
+		setCmd, _, err := root.Find([]string{"config", "set-context"})
+		Expect(err).ShouldNot(HaveOccurred())
+		setCmd.SetErr(errOut)
+		Expect(ApplyCurrentContext(setCmd)).Should(Succeed())
+		Expect(errOut.String()).Should(BeEmpty())
+
+		By("set-context repairs the invalid config file")
+		setCmd = newSetContextCmd(streams)
+		Expect(setCmd.Flags().Set("namespace", "dev")).Should(Succeed())
+		o := &setContextOptions{name: "dev", context: ContextDefaults{Namespace: "dev"}, IOStreams: streams}
+		Expect(o.run(setCmd)).Should(Succeed())
+		c, err := LoadConfig(configPath)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(c.CurrentContext).Should(Equal("dev"))
+	})
+
+	It("validate config", func() {
+		Expect(os.WriteFile(configPath, []byte("current-context: dev\n"), 0600)).Should(Succeed())
+		_, err := LoadConfig(configPath)
+		Expect(err).Should(MatchError(ContainSubstring("does not exist")))
+
+		Expect(os.WriteFile(configPath, []byte("contexts:\n  dev:\n    output: xml\n"), 0600)).Should(Succeed())
+		_, err = LoadConfig(configPath)
+		Expect(err).Should(MatchError(ContainSubstring("invalid output format")))
+
+		Expect(os.WriteFile(configPath, []byte("contexts:\n  dev:\n    namespace: Dev_NS\n"), 0600)).Should(Succeed())
+		_, err = LoadConfig(configPath)
+		Expect(err).Should(MatchError(ContainSubstring("invalid namespace")))
+
+		c, err := LoadConfig(filepath.Join(GinkgoT().TempDir(), configFileName))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(c.Contexts).Should(BeEmpty())
+	})
+})
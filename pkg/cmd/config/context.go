@@ -0,0 +1,173 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/apecloud/kbcli/pkg/printer"
+)
+
+var (
+	setContextExample = templates.Examples(`
+	# set the default namespace and output format of context dev
+	kbcli config set-context dev --namespace dev --output json
+
+	# set the default cluster of context dev
+	kbcli config set-context dev --cluster mycluster`)
+
+	useContextExample = templates.Examples(`
+	# use the context dev
+	kbcli config use-context dev`)
+)
+
+type setContextOptions struct {
+	name    string
+	context ContextDefaults
+	// configPath is the path of the config file, the default one is used if empty
+	configPath string
+
+	genericiooptions.IOStreams
+}
+
+func newSetContextCmd(streams genericiooptions.IOStreams) *cobra.Command {
+	o := &setContextOptions{IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:     "set-context NAME [--namespace=namespace] [--output=format] [--cluster=cluster]",
+		Short:   "Set a context entry in kbcli config, the specified fields are changed and others are kept.",
+		Example: setContextExample,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			o.name = args[0]
+			cmdutil.CheckErr(o.run(cmd))
+		},
+	}
+	// namespace flag is a global flag, use a local one to avoid using the namespace of kubeconfig
+	cmd.Flags().StringVar(&o.context.Namespace, "namespace", "", "The default namespace of the context")
+	cmd.Flags().StringVar(&o.context.Output, "output", "", fmt.Sprintf("The default output format of the context. Allowed values: %v", printer.Formats()))
+	cmd.Flags().StringVar(&o.context.Cluster, "cluster", "", "The default KubeBlocks cluster of the context")
+	return cmd
+}
+
+func (o *setContextOptions) run(cmd *cobra.Command) error {
+	path, err := resolveConfigPath(o.configPath)
+	if err != nil {
+		return err
+	}
+	c, err := readConfig(path)
+	if err != nil {
+		return err
+	}
+	if c.Contexts == nil {
+		c.Contexts = map[string]*ContextDefaults{}
+	}
+	ctx, exists := c.Contexts[o.name]
+	if !exists {
+		ctx = &ContextDefaults{}
+		c.Contexts[o.name] = ctx
+	}
+	if cmd.Flags().Changed("namespace") {
+		ctx.Namespace = o.context.Namespace
+	}
+	if cmd.Flags().Changed("output") {
+		ctx.Output = o.context.Output
+	}
+	if cmd.Flags().Changed("cluster") {
+		ctx.Cluster = o.context.Cluster
+	}
+	if err = SaveConfig(path, c); err != nil {
+		return err
+	}
+	if exists {
+		fmt.Fprintf(o.Out, "Context %q modified.\n", o.name)
+	} else {
+		fmt.Fprintf(o.Out, "Context %q created.\n", o.name)
+	}
+	return nil
+}
+
+type useContextOptions struct {
+	name       string
+	configPath string
+
+	genericiooptions.IOStreams
+}
+
+func newUseContextCmd(streams genericiooptions.IOStreams) *cobra.Command {
+	o := &useContextOptions{IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:     "use-context NAME",
+		Short:   "Set the current context in kbcli config.",
+		Example: useContextExample,
+		Args:    cobra.ExactArgs(1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			path, err := resolveConfigPath(o.configPath)
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			c, err := readConfig(path)
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return contextNames(c), cobra.ShellCompDirectiveNoFileComp
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			o.name = args[0]
+			cmdutil.CheckErr(o.run())
+		},
+	}
+	return cmd
+}
+
+func (o *useContextOptions) run() error {
+	path, err := resolveConfigPath(o.configPath)
+	if err != nil {
+		return err
+	}
+	c, err := readConfig(path)
+	if err != nil {
+		return err
+	}
+	if c.Contexts[o.name] == nil {
+		return fmt.Errorf("context %q does not exist, available contexts: %v", o.name, contextNames(c))
+	}
+	c.CurrentContext = o.name
+	if err = SaveConfig(path, c); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "Switched to context %q.\n", o.name)
+	return nil
+}
+
+// resolveConfigPath returns the specified path, or the default config file path if it is empty
+func resolveConfigPath(path string) (string, error) {
+	if path != "" {
+		return path, nil
+	}
+	return configFilePath()
+}
@@ -0,0 +1,288 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	kbconfig "github.com/apecloud/kbcli/pkg/config"
+	"github.com/apecloud/kbcli/pkg/printer"
+	"github.com/apecloud/kbcli/pkg/util"
+)
+
+var (
+	createProfileExample = templates.Examples(`
+	# create a profile dev which connects to the context dev-cluster of kubeconfig ~/.kube/dev
+	kbcli profile create dev --kubeconfig ~/.kube/dev --context dev-cluster --namespace dev
+
+	# use the profile dev
+	kbcli cluster list --profile dev`)
+
+	listProfileExample = templates.Examples(`
+	# list all profiles
+	kbcli profile list`)
+
+	deleteProfileExample = templates.Examples(`
+	# delete the profile dev
+	kbcli profile delete dev`)
+)
+
+// NewProfileCmd creates the profile command
+func NewProfileCmd(streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile",
+		Short: "Manage kbcli connection profiles.",
+		Long: templates.LongDesc(`
+		Manage the kbcli connection profiles stored in $HOME/.kbcli/profiles.yaml, or $KBCLI_HOME/profiles.yaml
+		if KBCLI_HOME is set.
+
+		A profile is selected by the global --profile flag, its settings are used as the values of the
+		flags which are not specified in the command line, and they take precedence over the current
+		kbcli context. The file format looks like:
+
+		    profiles:
+		      dev:
+		        kubeconfig: /home/user/.kube/dev  # --kubeconfig
+		        context: dev-cluster               # --context
+		        namespace: dev                     # --namespace
+		        output: json                       # --output of the commands that support it`),
+	}
+	cmd.AddCommand(
+		newCreateProfileCmd(streams),
+		newListProfileCmd(streams),
+		newDeleteProfileCmd(streams),
+	)
+	return cmd
+}
+
+type createProfileOptions struct {
+	name    string
+	profile kbconfig.Profile
+	// profilesPath is the path of the profiles file, the default one is used if empty
+	profilesPath string
+
+	genericiooptions.IOStreams
+}
+
+func newCreateProfileCmd(streams genericiooptions.IOStreams) *cobra.Command {
+	o := &createProfileOptions{IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:     "create NAME [--kubeconfig=path] [--context=context] [--namespace=namespace] [--output=format]",
+		Short:   "Create a connection profile.",
+		Example: createProfileExample,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			o.name = args[0]
+			cmdutil.CheckErr(o.run(cmd))
+		},
+	}
+	// these flags are global flags, use local ones to avoid using the values of the current kubeconfig
+	cmd.Flags().StringVar(&o.profile.Kubeconfig, "kubeconfig", "", "The path of the kubeconfig file of the profile")
+	cmd.Flags().StringVar(&o.profile.Context, "context", "", "The kubeconfig context of the profile")
+	cmd.Flags().StringVar(&o.profile.Namespace, "namespace", "", "The default namespace of the profile")
+	cmd.Flags().StringVar(&o.profile.Output, "output", "", fmt.Sprintf("The default output format of the profile. Allowed values: %v", printer.Formats()))
+	return cmd
+}
+
+func (o *createProfileOptions) run(cmd *cobra.Command) error {
+	path, err := resolveProfilesPath(o.profilesPath)
+	if err != nil {
+		return err
+	}
+	p, err := kbconfig.LoadProfiles(path)
+	if err != nil {
+		return err
+	}
+	if p.Profiles[o.name] != nil {
+		return fmt.Errorf("profile %q already exists, delete it before creating a new one", o.name)
+	}
+	// only the flags specified in the command line are saved
+	profile := &kbconfig.Profile{}
+	if cmd.Flags().Changed("kubeconfig") {
+		if profile.Kubeconfig, err = filepath.Abs(o.profile.Kubeconfig); err != nil {
+			return err
+		}
+	}
+	if cmd.Flags().Changed("context") {
+		profile.Context = o.profile.Context
+	}
+	if cmd.Flags().Changed("namespace") {
+		profile.Namespace = o.profile.Namespace
+	}
+	if cmd.Flags().Changed("output") {
+		profile.Output = o.profile.Output
+	}
+	if p.Profiles == nil {
+		p.Profiles = map[string]*kbconfig.Profile{}
+	}
+	p.Profiles[o.name] = profile
+	if err = kbconfig.SaveProfiles(path, p); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "Profile %q created.\n", o.name)
+	return nil
+}
+
+type listProfileOptions struct {
+	profilesPath string
+
+	genericiooptions.IOStreams
+}
+
+func newListProfileCmd(streams genericiooptions.IOStreams) *cobra.Command {
+	o := &listProfileOptions{IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List the connection profiles.",
+		Example: listProfileExample,
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.run())
+		},
+	}
+	return cmd
+}
+
+func (o *listProfileOptions) run() error {
+	path, err := resolveProfilesPath(o.profilesPath)
+	if err != nil {
+		return err
+	}
+	p, err := kbconfig.LoadProfiles(path)
+	if err != nil {
+		return err
+	}
+	if len(p.Profiles) == 0 {
+		fmt.Fprintln(o.Out, "No profile found")
+		return nil
+	}
+	tbl := printer.NewTablePrinter(o.Out)
+	tbl.SetHeader("NAME", "KUBECONFIG", "CONTEXT", "NAMESPACE", "OUTPUT")
+	for _, name := range p.Names() {
+		profile := p.Profiles[name]
+		tbl.AddRow(name, util.CheckEmpty(profile.Kubeconfig), util.CheckEmpty(profile.Context),
+			util.CheckEmpty(profile.Namespace), util.CheckEmpty(profile.Output))
+	}
+	tbl.Print()
+	return nil
+}
+
+type deleteProfileOptions struct {
+	name         string
+	profilesPath string
+
+	genericiooptions.IOStreams
+}
+
+func newDeleteProfileCmd(streams genericiooptions.IOStreams) *cobra.Command {
+	o := &deleteProfileOptions{IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:               "delete NAME",
+		Short:             "Delete a connection profile.",
+		Example:           deleteProfileExample,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeProfileNames,
+		Run: func(cmd *cobra.Command, args []string) {
+			o.name = args[0]
+			cmdutil.CheckErr(o.run())
+		},
+	}
+	return cmd
+}
+
+func (o *deleteProfileOptions) run() error {
+	path, err := resolveProfilesPath(o.profilesPath)
+	if err != nil {
+		return err
+	}
+	p, err := kbconfig.LoadProfiles(path)
+	if err != nil {
+		return err
+	}
+	if p.Profiles[o.name] == nil {
+		return fmt.Errorf("profile %q does not exist, available profiles: %v", o.name, p.Names())
+	}
+	delete(p.Profiles, o.name)
+	if err = kbconfig.SaveProfiles(path, p); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "Profile %q deleted.\n", o.name)
+	return nil
+}
+
+// resolveProfilesPath returns the specified path, or the default profiles file path if it is empty
+func resolveProfilesPath(path string) (string, error) {
+	if path != "" {
+		return path, nil
+	}
+	return kbconfig.ProfilesFilePath()
+}
+
+// ApplyProfile sets the values of the named profile to the flags which are not specified
+// explicitly in the command line, it does nothing if the name is empty.
+func ApplyProfile(cmd *cobra.Command, name string) error {
+	if name == "" {
+		return nil
+	}
+	path, err := kbconfig.ProfilesFilePath()
+	if err != nil {
+		return err
+	}
+	p, err := kbconfig.LoadProfiles(path)
+	if err != nil {
+		return err
+	}
+	profile := p.Profiles[name]
+	if profile == nil {
+		return fmt.Errorf("profile %q does not exist, available profiles: %v", name, p.Names())
+	}
+	setFlagDefault(cmd.Flags(), "kubeconfig", profile.Kubeconfig)
+	setFlagDefault(cmd.Flags(), "context", profile.Context)
+	setFlagDefault(cmd.Flags(), "namespace", profile.Namespace)
+	setFlagDefault(cmd.LocalFlags(), "output", profile.Output)
+	return nil
+}
+
+// CompleteProfileNames completes the names of the profiles
+func CompleteProfileNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	path, err := kbconfig.ProfilesFilePath()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	p, err := kbconfig.LoadProfiles(path)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return p.Names(), cobra.ShellCompDirectiveNoFileComp
+}
+
+func completeProfileNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return CompleteProfileNames(cmd, args, toComplete)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	kbconfig "github.com/apecloud/kbcli/pkg/config"
+	"github.com/apecloud/kbcli/pkg/printer"
+	"github.com/apecloud/kbcli/pkg/types"
+)
+
+var _ = Describe("profile", func() {
+	var (
+		streams      genericiooptions.IOStreams
+		out          *bytes.Buffer
+		profilesPath string
+	)
+
+	BeforeEach(func() {
+		streams, _, out, _ = genericiooptions.NewTestIOStreams()
+		home := GinkgoT().TempDir()
+		GinkgoT().Setenv(types.CliHomeEnv, home)
+		profilesPath = filepath.Join(home, kbconfig.ProfilesFileName)
+	})
+
+	It("create, list and delete profiles", func() {
+		cmd := NewProfileCmd(streams)
+		Expect(cmd).ShouldNot(BeNil())
+
+		By("no profile")
+		Expect((&listProfileOptions{IOStreams: streams}).run()).Should(Succeed())
+		Expect(out.String()).Should(ContainSubstring("No profile found"))
+
+		By("create profile")
+		createCmd := newCreateProfileCmd(streams)
+		Expect(createCmd.Flags().Set("kubeconfig", "/tmp/kubeconfig")).Should(Succeed())
+		Expect(createCmd.Flags().Set("namespace", "dev")).Should(Succeed())
+		o := &createProfileOptions{name: "dev", profile: kbconfig.Profile{Kubeconfig: "/tmp/kubeconfig", Context: "ignored", Namespace: "dev"}, IOStreams: streams}
+		Expect(o.run(createCmd)).Should(Succeed())
+		p, err := kbconfig.LoadProfiles(profilesPath)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(p.Profiles["dev"]).Should(Equal(&kbconfig.Profile{Kubeconfig: "/tmp/kubeconfig", Namespace: "dev"}))
+		Expect(o.run(createCmd)).Should(MatchError(ContainSubstring("already exists")))
+
+		By("list profiles")
+		out.Reset()
+		Expect((&listProfileOptions{IOStreams: streams}).run()).Should(Succeed())
+		Expect(out.String()).Should(ContainSubstring("/tmp/kubeconfig"))
+
+		By("delete profile")
+		Expect((&deleteProfileOptions{name: "test", IOStreams: streams}).run()).Should(MatchError(ContainSubstring("does not exist")))
+		Expect((&deleteProfileOptions{name: "dev", IOStreams: streams}).run()).Should(Succeed())
+		p, err = kbconfig.LoadProfiles(profilesPath)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(p.Profiles).Should(BeEmpty())
+	})
+
+	It("apply profile", func() {
+		Expect(os.WriteFile(profilesPath, []byte("profiles:\n  dev:\n    kubeconfig: /tmp/kubeconfig\n    context: dev-cluster\n    namespace: dev\n    output: yaml\n"), 0600)).Should(Succeed())
+		var (
+			format     printer.Format
+			kubeconfig string
+			namespace  string
+		)
+		listCmd := &cobra.Command{Use: "list"}
+		printer.AddOutputFlag(listCmd, &format)
+		listCmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "")
+		listCmd.Flags().StringVar(&namespace, "namespace", "", "")
+
+		By("no profile is specified")
+		Expect(ApplyProfile(listCmd, "")).Should(Succeed())
+		Expect(kubeconfig).Should(BeEmpty())
+
+		By("the profile does not exist")
+		Expect(ApplyProfile(listCmd, "test")).Should(MatchError(ContainSubstring("available profiles: [dev]")))
+
+		By("the specified flags are not overridden")
+		Expect(listCmd.Flags().Set("namespace", "default")).Should(Succeed())
+		Expect(ApplyProfile(listCmd, "dev")).Should(Succeed())
+		Expect(kubeconfig).Should(Equal("/tmp/kubeconfig"))
+		Expect(format).Should(Equal(printer.YAML))
+		Expect(namespace).Should(Equal("default"))
+	})
+
+})
@@ -47,6 +47,15 @@ var (
 
 		# create a backup from a parent backup
 		kbcli dp backup mybackup --cluster mycluster --parent-backup myparentbackup
+
+		# print the backup OpsRequest that would be created without submitting it
+		kbcli dp backup mybackup --cluster mycluster --dry-run -o yaml
+
+		# create a backup and wait for it to complete
+		kbcli dp backup mybackup --cluster mycluster --wait --wait-timeout 30m
+
+		# create a backup, wait for it to complete and push its duration to the Prometheus push gateway
+		kbcli dp backup mybackup --cluster mycluster --wait --metrics-pushgateway http://pushgateway:9091
 	`)
 
 	deleteBackupExample = templates.Examples(`
@@ -65,6 +74,18 @@ var (
 
 		# list all backups of specified cluster
 		kbcli dp list-backups --cluster mycluster
+
+		# list the backups matching the label selector across all namespaces
+		kbcli dp list-backups -l app.kubernetes.io/instance=mycluster -A
+
+		# list the backups started in the last 24 hours
+		kbcli dp list-backups --since 24h
+
+		# list the running and failed backups
+		kbcli dp list-backups --phase Running,Failed
+
+		# list the failed backups of the specified cluster in the last 7 days
+		kbcli dp list-backups --cluster mycluster --phase Failed --since 168h
 	`)
 )
 
@@ -100,10 +121,12 @@ func newBackupCommand(f cmdutil.Factory, streams genericiooptions.IOStreams) *co
 			if clusterName != "" {
 				o.Args = []string{clusterName}
 			}
+			o.BackupGVR = getBackupGVR(cmd)
 			cmdutil.BehaviorOnFatal(printer.FatalWithRedColor)
 			cmdutil.CheckErr(o.CompleteBackup())
 			cmdutil.CheckErr(o.Validate())
 			cmdutil.CheckErr(o.Run())
+			cmdutil.CheckErr(o.WaitBackup())
 		},
 	}
 
@@ -113,6 +136,8 @@ func newBackupCommand(f cmdutil.Factory, streams genericiooptions.IOStreams) *co
 	cmd.Flags().StringVar(&o.BackupSpec.DeletionPolicy, "deletion-policy", "Delete", "Deletion policy for backup, determine whether the backup content in backup repo will be deleted after the backup is deleted, supported values: [Delete, Retain]")
 	cmd.Flags().StringVar(&o.BackupSpec.RetentionPeriod, "retention-period", "", "Retention period for backup, supported values: [1y, 1mo, 1d, 1h, 1m] or combine them [1y1mo1d1h1m], if not specified, the backup will not be automatically deleted, you need to manually delete it.")
 	cmd.Flags().StringVar(&o.BackupSpec.ParentBackupName, "parent-backup", "", "Parent backup name, used for incremental backup")
+	o.AddDryRunFlags(cmd)
+	o.AddWaitFlags(cmd)
 	util.RegisterClusterCompletionFunc(cmd, f)
 	o.RegisterBackupFlagCompletionFunc(cmd, f)
 
@@ -121,21 +146,25 @@ func newBackupCommand(f cmdutil.Factory, streams genericiooptions.IOStreams) *co
 
 func newBackupDeleteCommand(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
 	o := action.NewDeleteOptions(f, streams, types.BackupGVR())
+	o.PreDeleteHook = cluster.PreDeleteBackup
 	clusterName := ""
 	cmd := &cobra.Command{
 		Use:               "delete-backup",
 		Short:             "Delete a backup.",
 		Example:           deleteBackupExample,
-		ValidArgsFunction: util.ResourceNameCompletionFunc(f, types.BackupGVR()),
+		ValidArgsFunction: backupNameCompletionFunc(f),
 		Run: func(cmd *cobra.Command, args []string) {
 			o.Names = args
+			o.AllNamespaces = getAllNamespaces(cmd)
+			o.GVR = getBackupGVR(cmd)
 			cmdutil.BehaviorOnFatal(printer.FatalWithRedColor)
 			util.CheckErr(completeForDeleteBackup(o, clusterName))
 			util.CheckErr(o.Run())
 		},
 	}
 
-	o.AddFlags(cmd)
+	// --all-namespaces is inherited from the data protection command
+	o.AddFlags(cmd, true)
 	cmd.Flags().StringVar(&clusterName, "cluster", "", "The cluster name.")
 	util.RegisterClusterCompletionFunc(cmd, f)
 
@@ -162,14 +191,16 @@ func newBackupDescribeCommand(f cmdutil.Factory, streams genericiooptions.IOStre
 		Use:               "describe-backup NAME",
 		Short:             "Describe a backup",
 		Aliases:           []string{"desc-backup"},
-		ValidArgsFunction: util.ResourceNameCompletionFunc(f, types.BackupGVR()),
+		ValidArgsFunction: backupNameCompletionFunc(f),
 		Example:           describeBackupExample,
 		Run: func(cmd *cobra.Command, args []string) {
+			o.Gvr = getBackupGVR(cmd)
 			cmdutil.BehaviorOnFatal(printer.FatalWithRedColor)
 			util.CheckErr(o.Complete(args))
 			util.CheckErr(o.Run())
 		},
 	}
+	o.AddFlags(cmd)
 	return cmd
 }
 
@@ -181,19 +212,24 @@ func newListBackupCommand(f cmdutil.Factory, streams genericiooptions.IOStreams)
 		Short:             "List backups.",
 		Aliases:           []string{"ls-backups"},
 		Example:           listBackupExample,
-		ValidArgsFunction: util.ResourceNameCompletionFunc(f, o.GVR),
+		ValidArgsFunction: backupNameCompletionFunc(f),
 		Run: func(cmd *cobra.Command, args []string) {
 			if clusterName != "" {
 				o.LabelSelector = util.BuildLabelSelectorByNames(o.LabelSelector, []string{clusterName})
 			}
 			o.Names = args
+			o.AllNamespaces = getAllNamespaces(cmd)
+			o.GVR = getBackupGVR(cmd)
 			cmdutil.BehaviorOnFatal(printer.FatalWithRedColor)
+			o.Ctx = cmd.Context()
 			cmdutil.CheckErr(o.Complete())
 			cmdutil.CheckErr(cluster.PrintBackupList(*o))
 		},
 	}
+	// --all-namespaces is inherited from the data protection command
 	o.AddFlags(cmd, true)
-	cmd.Flags().StringVar(&clusterName, "cluster", "", "List backups in the specified cluster")
+	o.AddBackupFlags(cmd)
+	cmd.Flags().StringVar(&clusterName, "cluster", "", "List backups in the specified cluster, it is equivalent to -l app.kubernetes.io/instance=<cluster> and can be combined with other filters such as --phase and --since")
 	util.RegisterClusterCompletionFunc(cmd, f)
 
 	return cmd
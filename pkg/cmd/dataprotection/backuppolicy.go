@@ -64,13 +64,15 @@ func newListBackupPolicyCmd(f cmdutil.Factory, streams genericclioptions.IOStrea
 				o.LabelSelector = util.BuildLabelSelectorByNames(o.LabelSelector, []string{clusterName})
 			}
 			o.Names = args
+			o.AllNamespaces = getAllNamespaces(cmd)
 			cmdutil.BehaviorOnFatal(printer.FatalWithRedColor)
 			util.CheckErr(o.Complete())
 			util.CheckErr(cluster.PrintBackupPolicyList(*o))
 		},
 	}
 	cmd.Flags().StringVar(&clusterName, "cluster", "", "The cluster name")
-	o.AddFlags(cmd)
+	// --all-namespaces is inherited from the data protection command
+	o.AddFlags(cmd, true)
 
 	return cmd
 }
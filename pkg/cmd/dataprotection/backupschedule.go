@@ -0,0 +1,193 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dataprotection
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/apecloud/kbcli/pkg/action"
+	"github.com/apecloud/kbcli/pkg/cmd/cluster"
+	"github.com/apecloud/kbcli/pkg/printer"
+	"github.com/apecloud/kbcli/pkg/types"
+	"github.com/apecloud/kbcli/pkg/util"
+)
+
+var (
+	listBackupScheduleExample = templates.Examples(`
+		# list all backup schedules
+		kbcli dp list-backup-schedule
+
+		# list the backup schedules of the specified cluster
+		kbcli dp list-bs --cluster mycluster
+	`)
+
+	createBackupScheduleExample = templates.Examples(`
+		# create a backup schedule for the cluster which backs up daily at 18:00 UTC with the default backup policy
+		kbcli dp create-backup-schedule --cluster mycluster --backup-method xtrabackup --cron-expression "0 18 * * *"
+
+		# create a backup schedule with the specified name and keep the backups for 7 days
+		kbcli dp create-backup-schedule myschedule --cluster mycluster --backup-method xtrabackup --cron-expression "0 18 * * *" --retention-period 7d
+	`)
+
+	enableBackupScheduleExample = templates.Examples(`
+		# enable all schedules of the backup schedule
+		kbcli dp enable-backup-schedule myschedule
+
+		# enable the schedule of the specified backup method
+		kbcli dp enable-backup-schedule myschedule --backup-method xtrabackup
+	`)
+
+	disableBackupScheduleExample = templates.Examples(`
+		# disable all schedules of the backup schedule
+		kbcli dp disable-backup-schedule myschedule
+
+		# disable the schedule of the specified backup method
+		kbcli dp disable-backup-schedule myschedule --backup-method xtrabackup
+	`)
+
+	deleteBackupScheduleExample = templates.Examples(`
+		# delete a backup schedule
+		kbcli dp delete-backup-schedule myschedule
+
+		# delete a backup schedule and all backups created by it
+		kbcli dp delete-backup-schedule myschedule --cascade
+	`)
+)
+
+func newListBackupScheduleCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
+	o := action.NewListOptions(f, streams, types.BackupScheduleGVR())
+	clusterName := ""
+	cmd := &cobra.Command{
+		Use:               "list-backup-schedule",
+		Short:             "List backup schedules.",
+		Aliases:           []string{"list-bs"},
+		Example:           listBackupScheduleExample,
+		ValidArgsFunction: util.ResourceNameCompletionFunc(f, types.BackupScheduleGVR()),
+		Run: func(cmd *cobra.Command, args []string) {
+			if clusterName != "" {
+				o.LabelSelector = util.BuildLabelSelectorByNames(o.LabelSelector, []string{clusterName})
+			}
+			o.Names = args
+			o.AllNamespaces = getAllNamespaces(cmd)
+			cmdutil.BehaviorOnFatal(printer.FatalWithRedColor)
+			util.CheckErr(o.Complete())
+			util.CheckErr(cluster.PrintBackupScheduleList(*o))
+		},
+	}
+	cmd.Flags().StringVar(&clusterName, "cluster", "", "The cluster name")
+	// --all-namespaces is inherited from the data protection command
+	o.AddFlags(cmd, true)
+	util.RegisterClusterCompletionFunc(cmd, f)
+
+	return cmd
+}
+
+func newCreateBackupScheduleCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &cluster.CreateBackupScheduleOptions{
+		Factory:   f,
+		IOStreams: streams,
+	}
+	cmd := &cobra.Command{
+		Use:     "create-backup-schedule [NAME]",
+		Short:   "Create a backup schedule for the cluster.",
+		Example: createBackupScheduleExample,
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) > 0 {
+				o.Name = args[0]
+			}
+			cmdutil.BehaviorOnFatal(printer.FatalWithRedColor)
+			util.CheckErr(o.Complete())
+			util.CheckErr(o.Validate())
+			util.CheckErr(o.Run())
+		},
+	}
+	cmd.Flags().StringVar(&o.ClusterName, "cluster", "", "Cluster name")
+	cmd.Flags().StringVar(&o.BackupPolicyName, "policy", "", "Backup policy name, if not specified, use the cluster default backup policy")
+	cmd.Flags().StringVar(&o.BackupMethod, "backup-method", "", "Backup method defined in the backup policy")
+	cmd.Flags().StringVar(&o.CronExpression, "cron-expression", "", "The cron expression for schedule, the timezone is in UTC. see https://en.wikipedia.org/wiki/Cron.")
+	cmd.Flags().StringVar(&o.RetentionPeriod, "retention-period", "", "Retention period for the scheduled backups, supported values: [1y, 1mo, 1d, 1h, 1m] or combine them [1y1mo1d1h1m], if not specified, the backups will not be automatically deleted")
+	util.RegisterClusterCompletionFunc(cmd, f)
+
+	return cmd
+}
+
+func newEnableBackupScheduleCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
+	return newToggleBackupScheduleCmd(f, streams, true)
+}
+
+func newDisableBackupScheduleCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
+	return newToggleBackupScheduleCmd(f, streams, false)
+}
+
+func newToggleBackupScheduleCmd(f cmdutil.Factory, streams genericiooptions.IOStreams, enabled bool) *cobra.Command {
+	o := &cluster.ToggleBackupScheduleOptions{
+		Enabled:   enabled,
+		Factory:   f,
+		IOStreams: streams,
+	}
+	cmd := &cobra.Command{
+		Use:               "enable-backup-schedule NAME",
+		Short:             "Enable the schedules of a backup schedule.",
+		Example:           enableBackupScheduleExample,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: util.ResourceNameCompletionFunc(f, types.BackupScheduleGVR()),
+		Run: func(cmd *cobra.Command, args []string) {
+			o.Name = args[0]
+			cmdutil.BehaviorOnFatal(printer.FatalWithRedColor)
+			util.CheckErr(o.Complete())
+			util.CheckErr(o.Validate())
+			util.CheckErr(o.Run())
+		},
+	}
+	if !enabled {
+		cmd.Use = "disable-backup-schedule NAME"
+		cmd.Short = "Disable the schedules of a backup schedule."
+		cmd.Example = disableBackupScheduleExample
+	}
+	cmd.Flags().StringVar(&o.BackupMethod, "backup-method", "", "The backup method of the schedule to toggle, all schedules are toggled if not specified")
+
+	return cmd
+}
+
+func newDeleteBackupScheduleCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
+	o := cluster.NewDeleteBackupScheduleOptions(f, streams)
+	cmd := &cobra.Command{
+		Use:               "delete-backup-schedule NAME",
+		Short:             "Delete backup schedules.",
+		Aliases:           []string{"delete-bs"},
+		Example:           deleteBackupScheduleExample,
+		ValidArgsFunction: util.ResourceNameCompletionFunc(f, types.BackupScheduleGVR()),
+		Run: func(cmd *cobra.Command, args []string) {
+			o.Names = args
+			o.AllNamespaces = getAllNamespaces(cmd)
+			cmdutil.BehaviorOnFatal(printer.FatalWithRedColor)
+			util.CheckErr(o.Run())
+		},
+	}
+	// --all-namespaces is inherited from the data protection command
+	o.AddFlags(cmd, true)
+	cmd.Flags().BoolVar(&o.Cascade, "cascade", false, "If true, delete the backups created by the backup schedule as well, it requires a separate confirmation")
+
+	return cmd
+}
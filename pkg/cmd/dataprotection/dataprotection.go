@@ -20,9 +20,33 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package dataprotection
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/apecloud/kbcli/pkg/types"
+	"github.com/apecloud/kbcli/pkg/util"
+)
+
+// allNamespacesFlag is a persistent flag of the data protection command, it is inherited by
+// all the subcommands, instead of being declared by each of them.
+const allNamespacesFlag = "all-namespaces"
+
+// allNamespacesCommands are the subcommands which list or delete the objects across all
+// namespaces with --all-namespaces, the others reject the flag.
+var allNamespacesCommands = []string{"list-backups", "delete-backup", "list-backup-policy",
+	"list-backup-schedule", "delete-backup-schedule"}
+
+// the flags to override the group, version and resource name of the backup API, they are used
+// with the custom KubeBlocks builds that serve backups with a different API.
+const (
+	backupResourceGroupFlag   = "backup-resource-group"
+	backupResourceVersionFlag = "backup-resource-version"
+	backupResourceNameFlag    = "backup-resource-name"
 )
 
 func NewDataProtectionCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
@@ -30,15 +54,76 @@ func NewDataProtectionCmd(f cmdutil.Factory, streams genericiooptions.IOStreams)
 		Use:     "dataprotection command",
 		Short:   "Data protection command.",
 		Aliases: []string{"dp"},
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return checkAllNamespaces(cmd)
+		},
 	}
+	cmd.PersistentFlags().BoolP(allNamespacesFlag, "A", false, "If present, list or delete the requested object(s) across all namespaces, it is only supported by the list and delete subcommands. Namespace in current context is ignored even if specified with --namespace.")
+	addBackupResourceFlags(cmd)
 	cmd.AddCommand(
 		newBackupCommand(f, streams),
 		newBackupDeleteCommand(f, streams),
 		newBackupDescribeCommand(f, streams),
 		newListBackupCommand(f, streams),
 		newRestoreCommand(f, streams),
+		newExportBackupCommand(f, streams),
 		newListBackupPolicyCmd(f, streams),
 		newDescribeBackupPolicyCmd(f, streams),
+		newListBackupScheduleCmd(f, streams),
+		newCreateBackupScheduleCmd(f, streams),
+		newEnableBackupScheduleCmd(f, streams),
+		newDisableBackupScheduleCmd(f, streams),
+		newDeleteBackupScheduleCmd(f, streams),
 	)
 	return cmd
 }
+
+// getAllNamespaces returns the value of the --all-namespaces flag inherited from the data protection command.
+func getAllNamespaces(cmd *cobra.Command) bool {
+	allNamespaces, _ := cmd.Flags().GetBool(allNamespacesFlag)
+	return allNamespaces
+}
+
+// checkAllNamespaces returns an error if --all-namespaces is specified for a subcommand which
+// operates on the objects in a single namespace, instead of ignoring it silently.
+func checkAllNamespaces(cmd *cobra.Command) error {
+	if !cmd.Flags().Changed(allNamespacesFlag) {
+		return nil
+	}
+	for _, name := range allNamespacesCommands {
+		if cmd.Name() == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("--%s is not supported by \"%s\", it is only supported by %s", allNamespacesFlag, cmd.CommandPath(), strings.Join(allNamespacesCommands, ", "))
+}
+
+// addBackupResourceFlags adds the persistent flags to override the backup resource, they are
+// inherited by all the subcommands and used wherever a subcommand reads or watches backups.
+func addBackupResourceFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(backupResourceGroupFlag, types.DPAPIGroup, "The API group of the backup resource")
+	cmd.PersistentFlags().String(backupResourceVersionFlag, types.DPAPIVersion, "The API version of the backup resource")
+	cmd.PersistentFlags().String(backupResourceNameFlag, types.ResourceBackups, "The resource name of the backup")
+}
+
+// getBackupGVR returns the GVR of the backup resource assembled from the flags added by addBackupResourceFlags.
+func getBackupGVR(cmd *cobra.Command) schema.GroupVersionResource {
+	gvr := types.BackupGVR()
+	if group, _ := cmd.Flags().GetString(backupResourceGroupFlag); group != "" {
+		gvr.Group = group
+	}
+	if version, _ := cmd.Flags().GetString(backupResourceVersionFlag); version != "" {
+		gvr.Version = version
+	}
+	if resource, _ := cmd.Flags().GetString(backupResourceNameFlag); resource != "" {
+		gvr.Resource = resource
+	}
+	return gvr
+}
+
+// backupNameCompletionFunc completes the backup names with the backup resource overridden by the flags.
+func backupNameCompletionFunc(f cmdutil.Factory) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return util.ResourceNameCompletionFunc(f, getBackupGVR(cmd))(cmd, args, toComplete)
+	}
+}
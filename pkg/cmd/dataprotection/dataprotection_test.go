@@ -0,0 +1,186 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dataprotection
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/exp/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	clientfake "k8s.io/client-go/rest/fake"
+	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+
+	"github.com/apecloud/kbcli/pkg/action"
+	"github.com/apecloud/kbcli/pkg/cmd/cluster"
+	"github.com/apecloud/kbcli/pkg/testing"
+	"github.com/apecloud/kbcli/pkg/types"
+)
+
+var _ = Describe("DataProtection", func() {
+	var (
+		streams genericiooptions.IOStreams
+		out     *bytes.Buffer
+		tf      *cmdtesting.TestFactory
+	)
+
+	BeforeEach(func() {
+		streams, _, out, _ = genericiooptions.NewTestIOStreams()
+		tf = cmdtesting.NewTestFactory().WithNamespace(testing.Namespace)
+		tf.Client = &clientfake.RESTClient{}
+		tf.WithDiscoveryClient(testing.FakeDiscoveryClient(&metav1.APIResourceList{
+			GroupVersion: types.BackupGVR().GroupVersion().String(),
+			APIResources: []metav1.APIResource{{Name: types.BackupGVR().Resource, Namespaced: true, Kind: types.KindBackup}},
+		}))
+	})
+
+	AfterEach(func() {
+		tf.Cleanup()
+	})
+
+	It("all subcommands inherit the --all-namespaces flag", func() {
+		cmd := NewDataProtectionCmd(tf, streams)
+		Expect(cmd.PersistentFlags().Lookup(allNamespacesFlag)).ShouldNot(BeNil())
+		for _, sub := range cmd.Commands() {
+			Expect(sub.LocalFlags().Lookup(allNamespacesFlag)).Should(BeNil(), sub.Name())
+			Expect(sub.InheritedFlags().ShorthandLookup("A")).ShouldNot(BeNil(), sub.Name())
+		}
+	})
+
+	It("the subcommands which operate in a single namespace reject --all-namespaces", func() {
+		cmd := NewDataProtectionCmd(tf, streams)
+		for _, sub := range cmd.Commands() {
+			Expect(sub.ParseFlags([]string{"-A"})).Should(Succeed())
+			err := checkAllNamespaces(sub)
+			if slices.Contains(allNamespacesCommands, sub.Name()) {
+				Expect(err).Should(Succeed(), sub.Name())
+			} else {
+				Expect(err).Should(MatchError(ContainSubstring("--all-namespaces is not supported")), sub.Name())
+			}
+		}
+
+		By("the flag is rejected before running the command")
+		cmd = NewDataProtectionCmd(tf, streams)
+		cmd.SetArgs([]string{"describe-backup", "backup1", "-A"})
+		Expect(cmd.Execute()).Should(MatchError(ContainSubstring("--all-namespaces is not supported by \"dataprotection describe-backup\"")))
+	})
+
+	It("list backups across all namespaces", func() {
+		backup1 := testing.FakeBackup("backup1")
+		backup2 := testing.FakeBackup("backup2")
+		backup2.Namespace = "other"
+		tf.FakeDynamicClient = testing.FakeDynamicClient(backup1, backup2)
+
+		By("list backups in the current namespace")
+		cmd := NewDataProtectionCmd(tf, streams)
+		cmd.SetArgs([]string{"list-backups"})
+		Expect(cmd.Execute()).Should(Succeed())
+		Expect(out.String()).Should(ContainSubstring("backup1"))
+		Expect(out.String()).ShouldNot(ContainSubstring("backup2"))
+
+		By("list backups with the --all-namespaces flag")
+		out.Reset()
+		cmd = NewDataProtectionCmd(tf, streams)
+		cmd.SetArgs([]string{"list-backups", "-A"})
+		Expect(cmd.Execute()).Should(Succeed())
+		Expect(out.String()).Should(ContainSubstring("backup1"))
+		Expect(out.String()).Should(ContainSubstring("backup2"))
+	})
+
+	It("list backups without the backup CRD", func() {
+		tf.WithDiscoveryClient(testing.FakeDiscoveryClient())
+		cmd := NewDataProtectionCmd(tf, streams)
+		sub, _, err := cmd.Find([]string{"list-backups"})
+		Expect(err).Should(Succeed())
+		Expect(sub.ParseFlags(nil)).Should(Succeed())
+		o := &cluster.ListBackupOptions{ListOptions: action.NewListOptions(tf, streams, getBackupGVR(sub))}
+		Expect(o.Complete()).Should(MatchError(ContainSubstring("the CRD of backups.dataprotection.kubeblocks.io is not found. Is KubeBlocks installed? Run: kbcli kubeblocks install")))
+
+		By("the custom backup resource is not installed")
+		Expect(sub.ParseFlags([]string{"--backup-resource-name=backupjobs"})).Should(Succeed())
+		tf.WithDiscoveryClient(testing.FakeDiscoveryClient(&metav1.APIResourceList{
+			GroupVersion: types.BackupGVR().GroupVersion().String(),
+			APIResources: []metav1.APIResource{{Name: types.BackupGVR().Resource, Namespaced: true, Kind: types.KindBackup}},
+		}))
+		o = &cluster.ListBackupOptions{ListOptions: action.NewListOptions(tf, streams, getBackupGVR(sub))}
+		Expect(o.Complete()).Should(MatchError(ContainSubstring("the CRD of backupjobs.dataprotection.kubeblocks.io is not found")))
+	})
+
+	It("override the backup resource with the flags", func() {
+		cmd := NewDataProtectionCmd(tf, streams)
+		Expect(cmd.PersistentFlags().Lookup(backupResourceNameFlag)).ShouldNot(BeNil())
+		for _, sub := range cmd.Commands() {
+			Expect(sub.InheritedFlags().Lookup(backupResourceNameFlag)).ShouldNot(BeNil(), sub.Name())
+		}
+
+		sub, _, err := cmd.Find([]string{"list-backups"})
+		Expect(err).Should(Succeed())
+		Expect(sub.ParseFlags(nil)).Should(Succeed())
+		Expect(getBackupGVR(sub)).Should(Equal(types.BackupGVR()))
+
+		Expect(sub.ParseFlags([]string{"--backup-resource-group=custom.kubeblocks.io", "--backup-resource-name=backupjobs"})).Should(Succeed())
+		gvr := getBackupGVR(sub)
+		Expect(gvr.Group).Should(Equal("custom.kubeblocks.io"))
+		Expect(gvr.Version).Should(Equal(types.DPAPIVersion))
+		Expect(gvr.Resource).Should(Equal("backupjobs"))
+	})
+
+	It("list backups with empty, partial and complete status", func() {
+		// the backup is just created, its status is not set yet
+		newBackup := testing.FakeBackup("backup-new")
+		runningBackup := testing.FakeBackup("backup-running")
+		runningBackup.Spec.BackupMethod = testing.BackupMethodName
+		runningBackup.Status.Phase = dpv1alpha1.BackupPhaseRunning
+		completedBackup := testing.FakeBackup("backup-completed")
+		completedBackup.Labels = map[string]string{constant.AppInstanceLabelKey: testing.ClusterName}
+		completedBackup.Spec.BackupMethod = testing.BackupMethodName
+		completedBackup.Status = dpv1alpha1.BackupStatus{
+			Phase:               dpv1alpha1.BackupPhaseCompleted,
+			TotalSize:           "1073741824",
+			StartTimestamp:      &metav1.Time{Time: completedBackup.CreationTimestamp.Add(-time.Minute)},
+			CompletionTimestamp: &metav1.Time{Time: completedBackup.CreationTimestamp.Time},
+			Expiration:          &metav1.Time{Time: completedBackup.CreationTimestamp.Add(7 * 24 * time.Hour)},
+		}
+		tf.FakeDynamicClient = testing.FakeDynamicClient(newBackup, runningBackup, completedBackup)
+
+		cmd := NewDataProtectionCmd(tf, streams)
+		cmd.SetArgs([]string{"list-backups"})
+		Expect(cmd.Execute()).Should(Succeed())
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		Expect(lines).Should(HaveLen(4))
+		Expect(lines[0]).Should(HavePrefix("NAME"))
+		rows := map[string][]string{}
+		for _, line := range lines[1:] {
+			fields := strings.Fields(line)
+			rows[fields[0]] = fields
+		}
+		Expect(rows).Should(HaveKey("backup-new"))
+		Expect(rows["backup-running"]).Should(ContainElements(testing.BackupMethodName, string(dpv1alpha1.BackupPhaseRunning)))
+		Expect(rows["backup-completed"]).Should(ContainElements(testing.ClusterName, testing.BackupMethodName,
+			string(dpv1alpha1.BackupPhaseCompleted), "1.0", "GiB", "60s"))
+	})
+})
@@ -0,0 +1,348 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+
+	"github.com/apecloud/kbcli/pkg/printer"
+	"github.com/apecloud/kbcli/pkg/spinner"
+	"github.com/apecloud/kbcli/pkg/types"
+	"github.com/apecloud/kbcli/pkg/util"
+)
+
+const (
+	// the keys of the backup repo config and credential for S3-compatible storage providers
+	s3BucketKey          = "bucket"
+	s3RegionKey          = "region"
+	s3EndpointKey        = "endpoint"
+	s3AccessKeyIDKey     = "accessKeyId"
+	s3SecretAccessKeyKey = "secretAccessKey"
+
+	// the key of the backup repo config for NFS storage provider
+	nfsServerKey = "nfsServer"
+
+	defaultS3Region = "us-east-1"
+)
+
+var exportBackupExample = templates.Examples(`
+		# export the backup files to the local directory ./mybackup
+		kbcli dp export-backup mybackup
+
+		# export the backup files to the specified directory
+		kbcli dp export-backup mybackup --output-dir /tmp/backups
+
+		# export the backup stored in a NFS backup repo, the NFS export is mounted to /mnt/nfs locally
+		kbcli dp export-backup mybackup --nfs-mount-dir /mnt/nfs
+	`)
+
+// notExportableError means the backup can not be exported by kbcli at all, such as the backups
+// stored in a kopia repository or in a backup repo of an unsupported storage provider.
+type notExportableError string
+
+func (e notExportableError) Error() string {
+	return string(e)
+}
+
+type exportBackupOptions struct {
+	Factory   cmdutil.Factory
+	client    kubernetes.Interface
+	dynamic   dynamic.Interface
+	backupGVR schema.GroupVersionResource
+	namespace string
+	name      string
+
+	// OutputDir is the local directory to save the backup files, the files are saved
+	// into a subdirectory named by the backup
+	OutputDir string
+	// NFSMountDir is the local directory where the NFS export of the backup repo is mounted
+	NFSMountDir string
+
+	genericiooptions.IOStreams
+}
+
+func newExportBackupCommand(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
+	o := &exportBackupOptions{Factory: f, IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:               "export-backup NAME",
+		Short:             "Download the files of a completed backup from the backup repo to local disk.",
+		Example:           exportBackupExample,
+		ValidArgsFunction: backupNameCompletionFunc(f),
+		Run: func(cmd *cobra.Command, args []string) {
+			o.backupGVR = getBackupGVR(cmd)
+			cmdutil.BehaviorOnFatal(printer.FatalWithRedColor)
+			util.CheckErr(o.complete(args))
+			util.CheckErr(o.run())
+		},
+	}
+	cmd.Flags().StringVar(&o.OutputDir, "output-dir", ".", "The local directory to save the backup files")
+	cmd.Flags().StringVar(&o.NFSMountDir, "nfs-mount-dir", "", "The local directory where the NFS export of the backup repo is mounted, required by NFS backup repo")
+	return cmd
+}
+
+func (o *exportBackupOptions) complete(args []string) error {
+	var err error
+	if len(args) != 1 {
+		return fmt.Errorf("missing backup name")
+	}
+	o.name = args[0]
+	if o.namespace, _, err = o.Factory.ToRawKubeConfigLoader().Namespace(); err != nil {
+		return err
+	}
+	if o.client, err = o.Factory.KubernetesClientSet(); err != nil {
+		return err
+	}
+	if o.dynamic, err = o.Factory.DynamicClient(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *exportBackupOptions) run() error {
+	backup := &dpv1alpha1.Backup{}
+	if err := util.GetK8SClientObject(o.dynamic, backup, o.backupGVR, o.namespace, o.name); err != nil {
+		return err
+	}
+	if backup.Status.Phase != dpv1alpha1.BackupPhaseCompleted {
+		return fmt.Errorf(`backup "%s" is %s, only completed backup can be exported`, o.name, backup.Status.Phase)
+	}
+	if backup.Status.KopiaRepoPath != "" {
+		return notExportableError(fmt.Sprintf(`backup "%s" is stored in a kopia repository, which can not be exported`, o.name))
+	}
+	if backup.Status.BackupRepoName == "" || backup.Status.Path == "" {
+		return notExportableError(fmt.Sprintf(`backup "%s" has no backup repo or path in its status`, o.name))
+	}
+	repo := &dpv1alpha1.BackupRepo{}
+	if err := util.GetK8SClientObject(o.dynamic, repo, types.BackupRepoGVR(), "", backup.Status.BackupRepoName); err != nil {
+		return err
+	}
+
+	// the backup path is relative to the path prefix of the backup repo
+	backupPath := strings.Trim(path.Join(repo.Spec.PathPrefix, backup.Status.Path), "/")
+	outputDir := filepath.Join(o.OutputDir, backup.Name)
+	var err error
+	switch {
+	case repo.Spec.Config[s3BucketKey] != "":
+		err = o.exportFromS3(repo, backupPath, outputDir)
+	case repo.Spec.Config[nfsServerKey] != "":
+		err = o.exportFromNFS(backupPath, outputDir)
+	default:
+		return notExportableError(fmt.Sprintf(`the storage provider "%s" of backup repo "%s" is not supported, only S3-compatible and NFS storage providers are supported`,
+			repo.Spec.StorageProviderRef, repo.Name))
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "Backup %s is exported to %s\n", backup.Name, outputDir)
+	return nil
+}
+
+// ExportCompletedBackups exports all completed backups in all namespaces to the output directory,
+// the backups of each namespace are saved into a subdirectory named by the namespace. The backups
+// which can not be exported, including the ones in NFS backup repos, are skipped with a warning,
+// and the errors of the other backups are returned after all backups are tried. The number of
+// the exported backups is returned.
+func ExportCompletedBackups(client kubernetes.Interface, dynamic dynamic.Interface, backupGVR schema.GroupVersionResource,
+	streams genericiooptions.IOStreams, outputDir string) (int, error) {
+	objs, err := dynamic.Resource(backupGVR).Namespace(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return 0, err
+	}
+	var (
+		exported int
+		allErrs  []error
+	)
+	for _, obj := range objs.Items {
+		phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+		if phase != string(dpv1alpha1.BackupPhaseCompleted) {
+			continue
+		}
+		o := &exportBackupOptions{
+			client:    client,
+			dynamic:   dynamic,
+			backupGVR: backupGVR,
+			namespace: obj.GetNamespace(),
+			name:      obj.GetName(),
+			OutputDir: filepath.Join(outputDir, obj.GetNamespace()),
+			IOStreams: streams,
+		}
+		err = o.run()
+		var notExportable notExportableError
+		switch {
+		case err == nil:
+			exported++
+		case errors.As(err, &notExportable):
+			printer.Warning(streams.ErrOut, "skip exporting backup %s/%s: %v\n", obj.GetNamespace(), obj.GetName(), err)
+		default:
+			allErrs = append(allErrs, fmt.Errorf("failed to export backup %s/%s: %v", obj.GetNamespace(), obj.GetName(), err))
+		}
+	}
+	return exported, utilerrors.NewAggregate(allErrs)
+}
+
+// exportFromS3 downloads all objects under the backup path from the S3-compatible storage,
+// the credential is read from the secret referenced by the backup repo.
+func (o *exportBackupOptions) exportFromS3(repo *dpv1alpha1.BackupRepo, backupPath, outputDir string) error {
+	if repo.Spec.Credential == nil {
+		return fmt.Errorf(`backup repo "%s" has no credential`, repo.Name)
+	}
+	secret, err := o.client.CoreV1().Secrets(repo.Spec.Credential.Namespace).Get(context.TODO(), repo.Spec.Credential.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	region := repo.Spec.Config[s3RegionKey]
+	if region == "" {
+		region = defaultS3Region
+	}
+	cfg := &aws.Config{
+		Region: aws.String(region),
+		Credentials: credentials.NewStaticCredentials(string(secret.Data[s3AccessKeyIDKey]),
+			string(secret.Data[s3SecretAccessKeyKey]), ""),
+	}
+	if endpoint := repo.Spec.Config[s3EndpointKey]; endpoint != "" {
+		// the S3-compatible storages such as MinIO only support path-style addressing
+		cfg.Endpoint = aws.String(endpoint)
+		cfg.S3ForcePathStyle = aws.Bool(true)
+	}
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return err
+	}
+	client := s3.New(sess)
+	bucket := repo.Spec.Config[s3BucketKey]
+
+	var objects []*s3.Object
+	if err = client.ListObjectsV2PagesWithContext(context.TODO(), &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(backupPath + "/"),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		objects = append(objects, page.Contents...)
+		return true
+	}); err != nil {
+		return err
+	}
+	if len(objects) == 0 {
+		return fmt.Errorf(`no files found in "%s" of bucket "%s"`, backupPath, bucket)
+	}
+	for _, obj := range objects {
+		key := aws.StringValue(obj.Key)
+		output, err := client.GetObjectWithContext(context.TODO(), &s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    obj.Key,
+		})
+		if err != nil {
+			return err
+		}
+		err = o.saveFile(output.Body, aws.Int64Value(obj.Size), strings.TrimPrefix(key, backupPath+"/"), outputDir)
+		output.Body.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportFromNFS copies the backup files from the locally mounted NFS export.
+func (o *exportBackupOptions) exportFromNFS(backupPath, outputDir string) error {
+	if o.NFSMountDir == "" {
+		return notExportableError("the backup is stored in a NFS backup repo, please mount the NFS export locally and specify the mount directory by --nfs-mount-dir")
+	}
+	srcDir := filepath.Join(o.NFSMountDir, filepath.FromSlash(backupPath))
+	return filepath.Walk(srcDir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(srcDir, p)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return o.saveFile(f, info.Size(), filepath.ToSlash(rel), outputDir)
+	})
+}
+
+// saveFile writes the content of the reader to the file in the output directory,
+// and shows the downloaded bytes with a spinner.
+func (o *exportBackupOptions) saveFile(r io.Reader, size int64, name, outputDir string) error {
+	dest := filepath.Join(outputDir, filepath.FromSlash(name))
+	if !strings.HasPrefix(dest, filepath.Clean(outputDir)+string(os.PathSeparator)) {
+		return fmt.Errorf(`invalid backup file name "%s"`, name)
+	}
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	s := spinner.New(o.Out, spinner.WithMessage(fmt.Sprintf("Downloading %s", name)))
+	w := &progressWriter{spinner: s, name: name, total: uint64(size)}
+	if _, err = io.Copy(f, io.TeeReader(r, w)); err != nil {
+		s.Fail()
+		return err
+	}
+	s.Success()
+	return nil
+}
+
+// progressWriter counts the bytes written to it and updates the spinner message.
+type progressWriter struct {
+	spinner spinner.Interface
+	name    string
+	total   uint64
+	written uint64
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.written += uint64(len(p))
+	w.spinner.SetMessage(fmt.Sprintf("Downloading %s (%s/%s)", w.name, humanize.Bytes(w.written), humanize.Bytes(w.total)))
+	return len(p), nil
+}
@@ -0,0 +1,174 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dataprotection
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/kubernetes/fake"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+
+	"github.com/apecloud/kbcli/pkg/testing"
+	"github.com/apecloud/kbcli/pkg/types"
+)
+
+var _ = Describe("export backup", func() {
+	var (
+		streams genericiooptions.IOStreams
+		out     *bytes.Buffer
+		errOut  *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		streams, _, out, errOut = genericiooptions.NewTestIOStreams()
+	})
+
+	newBackup := func(name string, phase dpv1alpha1.BackupPhase, repo string) *dpv1alpha1.Backup {
+		backup := testing.FakeBackup(name)
+		backup.Status.Phase = phase
+		backup.Status.BackupRepoName = repo
+		backup.Status.Path = "/" + name
+		return backup
+	}
+
+	It("validate the backup to export", func() {
+		noRepoBackup := newBackup("no-repo", dpv1alpha1.BackupPhaseCompleted, "")
+		kopiaBackup := newBackup("kopia", dpv1alpha1.BackupPhaseCompleted, "s3-repo")
+		kopiaBackup.Status.KopiaRepoPath = "/kopia"
+		o := &exportBackupOptions{
+			dynamic: testing.FakeDynamicClient(
+				newBackup("running", dpv1alpha1.BackupPhaseRunning, "s3-repo"),
+				kopiaBackup,
+				noRepoBackup,
+				newBackup("unknown-repo", dpv1alpha1.BackupPhaseCompleted, "unknown-repo"),
+				testing.FakeBackupRepo("unknown-repo", false),
+			),
+			backupGVR: types.BackupGVR(),
+			namespace: testing.Namespace,
+			IOStreams: streams,
+		}
+		var notExportable notExportableError
+
+		By("only completed backup can be exported")
+		o.name = "running"
+		Expect(o.run()).Should(MatchError(ContainSubstring("only completed backup can be exported")))
+
+		By("the backups in kopia repository can not be exported")
+		o.name = "kopia"
+		err := o.run()
+		Expect(errors.As(err, &notExportable)).Should(BeTrue())
+		Expect(err).Should(MatchError(ContainSubstring("kopia repository")))
+
+		By("the backups without repo can not be exported")
+		o.name = "no-repo"
+		err = o.run()
+		Expect(errors.As(err, &notExportable)).Should(BeTrue())
+		Expect(err).Should(MatchError(ContainSubstring("has no backup repo or path")))
+
+		By("the backups in the repo of unsupported storage provider can not be exported")
+		o.name = "unknown-repo"
+		err = o.run()
+		Expect(errors.As(err, &notExportable)).Should(BeTrue())
+		Expect(err).Should(MatchError(ContainSubstring(`the storage provider "fake-storage-provider" of backup repo "unknown-repo" is not supported`)))
+	})
+
+	It("export the backup from NFS", func() {
+		nfsRepo := testing.FakeBackupRepo("nfs-repo", false)
+		nfsRepo.Spec.Config = map[string]string{nfsServerKey: "10.0.0.1"}
+		nfsRepo.Spec.PathPrefix = "/prefix"
+		backup := newBackup("nfs", dpv1alpha1.BackupPhaseCompleted, nfsRepo.Name)
+		mountDir, outputDir := GinkgoT().TempDir(), GinkgoT().TempDir()
+		o := &exportBackupOptions{
+			dynamic:   testing.FakeDynamicClient(backup, nfsRepo),
+			backupGVR: types.BackupGVR(),
+			namespace: testing.Namespace,
+			name:      backup.Name,
+			OutputDir: outputDir,
+			IOStreams: streams,
+		}
+
+		By("the mount directory is required")
+		var notExportable notExportableError
+		Expect(errors.As(o.run(), &notExportable)).Should(BeTrue())
+
+		By("copy the backup files from the mount directory")
+		backupDir := filepath.Join(mountDir, "prefix", backup.Name)
+		Expect(os.MkdirAll(filepath.Join(backupDir, "data"), 0755)).Should(Succeed())
+		Expect(os.WriteFile(filepath.Join(backupDir, "backup.info"), []byte("info"), 0644)).Should(Succeed())
+		Expect(os.WriteFile(filepath.Join(backupDir, "data", "backup.xbstream"), []byte("data"), 0644)).Should(Succeed())
+		o.NFSMountDir = mountDir
+		Expect(o.run()).Should(Succeed())
+		Expect(out.String()).Should(ContainSubstring(fmt.Sprintf("Backup %s is exported to %s", backup.Name, filepath.Join(outputDir, backup.Name))))
+		content, err := os.ReadFile(filepath.Join(outputDir, backup.Name, "backup.info"))
+		Expect(err).Should(Succeed())
+		Expect(string(content)).Should(Equal("info"))
+		content, err = os.ReadFile(filepath.Join(outputDir, backup.Name, "data", "backup.xbstream"))
+		Expect(err).Should(Succeed())
+		Expect(string(content)).Should(Equal("data"))
+	})
+
+	It("save the backup file", func() {
+		outputDir := GinkgoT().TempDir()
+		o := &exportBackupOptions{IOStreams: streams}
+		Expect(o.saveFile(strings.NewReader("data"), 4, "data/file", outputDir)).Should(Succeed())
+		content, err := os.ReadFile(filepath.Join(outputDir, "data", "file"))
+		Expect(err).Should(Succeed())
+		Expect(string(content)).Should(Equal("data"))
+
+		By("the file can not be saved out of the output directory")
+		for _, name := range []string{"../file", "data/../../file"} {
+			Expect(o.saveFile(strings.NewReader("data"), 4, name, outputDir)).Should(MatchError(ContainSubstring("invalid backup file name")))
+		}
+		_, err = os.Stat(filepath.Join(filepath.Dir(outputDir), "file"))
+		Expect(os.IsNotExist(err)).Should(BeTrue())
+	})
+
+	It("export the completed backups", func() {
+		kopiaBackup := newBackup("kopia", dpv1alpha1.BackupPhaseCompleted, "s3-repo")
+		kopiaBackup.Status.KopiaRepoPath = "/kopia"
+		nfsRepo := testing.FakeBackupRepo("nfs-repo", false)
+		nfsRepo.Spec.Config = map[string]string{nfsServerKey: "10.0.0.1"}
+		dynamic := testing.FakeDynamicClient(
+			kopiaBackup,
+			newBackup("nfs", dpv1alpha1.BackupPhaseCompleted, nfsRepo.Name),
+			newBackup("no-repo", dpv1alpha1.BackupPhaseCompleted, "not-exist"),
+			newBackup("running", dpv1alpha1.BackupPhaseRunning, "not-exist"),
+			nfsRepo,
+		)
+
+		exported, err := ExportCompletedBackups(fake.NewSimpleClientset(), dynamic, types.BackupGVR(), streams, GinkgoT().TempDir())
+		Expect(exported).Should(BeZero())
+		Expect(err).Should(MatchError(ContainSubstring(fmt.Sprintf("failed to export backup %s/no-repo", testing.Namespace))))
+		Expect(err.Error()).ShouldNot(ContainSubstring("running"))
+		By("the backups which can not be exported are skipped")
+		Expect(err.Error()).ShouldNot(ContainSubstring("kopia"))
+		Expect(errOut.String()).Should(ContainSubstring(fmt.Sprintf("skip exporting backup %s/kopia", testing.Namespace)))
+		Expect(errOut.String()).Should(ContainSubstring(fmt.Sprintf("skip exporting backup %s/nfs", testing.Namespace)))
+	})
+})
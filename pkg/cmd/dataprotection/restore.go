@@ -37,7 +37,10 @@ import (
 var (
 	createRestoreExample = templates.Examples(`
 		# restore a new cluster from a backup
-		kbcli dp restore mybackup --cluster cluster-name`)
+		kbcli dp restore mybackup --cluster cluster-name
+
+		# restore a new cluster from a backup and wait for the restore to complete
+		kbcli dp restore mybackup --cluster cluster-name --wait`)
 )
 
 func newRestoreCommand(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
@@ -61,7 +64,7 @@ func newRestoreCommand(f cmdutil.Factory, streams genericiooptions.IOStreams) *c
 	cmd := &cobra.Command{
 		Use:               "restore",
 		Short:             "Restore a new cluster from backup",
-		ValidArgsFunction: util.ResourceNameCompletionFunc(f, types.BackupGVR()),
+		ValidArgsFunction: backupNameCompletionFunc(f),
 		Example:           createRestoreExample,
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) > 0 {
@@ -70,15 +73,18 @@ func newRestoreCommand(f cmdutil.Factory, streams genericiooptions.IOStreams) *c
 			if clusterName != "" {
 				o.Args = []string{clusterName}
 			}
+			o.BackupGVR = getBackupGVR(cmd)
 			cmdutil.BehaviorOnFatal(printer.FatalWithRedColor)
 			util.CheckErr(o.Complete())
 			util.CheckErr(o.Validate())
 			util.CheckErr(o.Run())
+			util.CheckErr(o.WaitRestore())
 		},
 	}
 
 	cmd.Flags().StringVar(&clusterName, "cluster", "", "The cluster to restore")
 	cmd.Flags().StringVar(&o.RestoreSpec.RestorePointInTime, "restore-to-time", "", "point in time recovery(PITR)")
 	cmd.Flags().StringVar(&o.RestoreSpec.VolumeRestorePolicy, "volume-restore-policy", "Parallel", "the volume claim restore policy, supported values: [Serial, Parallel]")
+	o.AddWaitFlags(cmd)
 	return cmd
 }
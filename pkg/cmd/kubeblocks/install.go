@@ -24,6 +24,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -96,6 +97,8 @@ type InstallOptions struct {
 	NodeLabels      map[string]string
 	TolerationsRaw  []string
 	upgrader        breakingchange.Upgrader
+	// valuesDiff receives the difference of chart default values when upgrading KubeBlocks version
+	valuesDiff io.Writer
 }
 
 type addonStatus struct {
@@ -370,6 +373,10 @@ You can check the KubeBlocks status by running "kbcli kubeblocks status"
 `, o.Version, o.HelmCfg.Namespace())
 		}
 		fmt.Fprint(o.Out, msg)
+		if o.Wait {
+			fmt.Fprintln(o.Out, "\nKubeBlocks CRDs:")
+			printKubeBlocksCRDs(context.TODO(), o.Dynamic, o.Out)
+		}
 		o.printNotes()
 	}
 	return nil
@@ -592,6 +599,7 @@ func (o *InstallOptions) buildChart() *helm.InstallOpts {
 		Timeout:         o.Timeout,
 		Atomic:          false,
 		Upgrader:        o.upgrader,
+		ValuesDiff:      o.valuesDiff,
 	}
 }
 
@@ -20,14 +20,21 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package kubeblocks
 
 import (
+	"bytes"
+	"context"
+	"strings"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"github.com/spf13/cobra"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 	clientfake "k8s.io/client-go/rest/fake"
 	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
 
+	"github.com/apecloud/kubeblocks/pkg/constant"
+
 	"github.com/apecloud/kbcli/pkg/testing"
 	"github.com/apecloud/kbcli/pkg/util"
 	"github.com/apecloud/kbcli/pkg/util/helm"
@@ -92,6 +99,20 @@ var _ = Describe("kubeblocks install", func() {
 		o.printNotes()
 	})
 
+	It("print installed CRDs", func() {
+		crds := mockCRD()
+		crds[0].(*apiextensionsv1.CustomResourceDefinition).Spec.Names.Kind = "Cluster"
+		crds[0].(*apiextensionsv1.CustomResourceDefinition).Spec.Versions = []apiextensionsv1.CustomResourceDefinitionVersion{
+			{Name: "v1alpha1", Served: true}, {Name: "v1alpha0", Served: false},
+		}
+		out := &bytes.Buffer{}
+		printKubeBlocksCRDs(context.TODO(), testing.FakeDynamicClient(crds...), out)
+		Expect(strings.Count(out.String(), constant.APIGroup)).Should(Equal(4))
+		Expect(out.String()).Should(ContainSubstring("clusters.apps.kubeblocks.io"))
+		Expect(out.String()).Should(ContainSubstring("v1alpha1"))
+		Expect(out.String()).ShouldNot(ContainSubstring("v1alpha0"))
+	})
+
 	It("checkVersion", func() {
 		o := &InstallOptions{
 			Options: Options{
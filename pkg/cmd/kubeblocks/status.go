@@ -44,6 +44,7 @@ import (
 	"k8s.io/kubectl/pkg/util/templates"
 	metrics "k8s.io/metrics/pkg/client/clientset/versioned"
 
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
 	extensionsv1alpha1 "github.com/apecloud/kubeblocks/apis/extensions/v1alpha1"
 	"github.com/apecloud/kubeblocks/pkg/constant"
 
@@ -175,8 +176,12 @@ func (o *statusOptions) run() error {
 	allErrs := make([]error, 0)
 	o.buildSelectorList(ctx, &allErrs)
 	o.showK8sClusterInfos(ctx, &allErrs)
+	running := o.showOperatorPods(ctx, &allErrs)
 	o.showWorkloads(ctx, &allErrs)
 	o.showAddons()
+	o.showCRDs(ctx)
+	o.showWebhooks(ctx, &allErrs)
+	o.showUnavailableClusterDefs(ctx, &allErrs)
 
 	if o.showAll {
 		o.showKubeBlocksResources(ctx, &allErrs)
@@ -185,9 +190,77 @@ func (o *statusOptions) run() error {
 		o.showKubeBlocksStorage(ctx, &allErrs)
 		o.showHelmResources(ctx, &allErrs)
 	}
+	if !running {
+		allErrs = append(allErrs, fmt.Errorf("KubeBlocks operator is not running"))
+	}
 	return errorutil.Aggregate(allErrs)
 }
 
+// showOperatorPods shows the pods of KubeBlocks operator, and returns true if any of them is running
+func (o *statusOptions) showOperatorPods(ctx context.Context, allErrs *[]error) bool {
+	fmt.Fprintln(o.Out, "\nKubeBlocks Operator:")
+	tblPrinter := printer.NewTablePrinter(o.Out)
+	tblPrinter.SetHeader("NAMESPACE", "NAME", "STATUS", "NODE", "CREATED-AT")
+	running := false
+	pods, err := o.client.CoreV1().Pods(o.ns).List(ctx, metav1.ListOptions{LabelSelector: buildKubeBlocksSelectorLabels()})
+	util.AppendErrIgnoreNotFound(allErrs, err)
+	if pods != nil {
+		for _, pod := range pods.Items {
+			if pod.Status.Phase == corev1.PodRunning {
+				running = true
+			}
+			tblPrinter.AddRow(pod.Namespace, pod.Name, pod.Status.Phase, pod.Spec.NodeName, util.TimeFormat(&pod.CreationTimestamp))
+		}
+	}
+	tblPrinter.Print()
+	return running
+}
+
+func (o *statusOptions) showCRDs(ctx context.Context) {
+	fmt.Fprintln(o.Out, "\nKubeBlocks CRDs:")
+	printKubeBlocksCRDs(ctx, o.dynamic, o.Out)
+}
+
+func (o *statusOptions) showWebhooks(ctx context.Context, allErrs *[]error) {
+	fmt.Fprintln(o.Out, "\nKubeBlocks Webhook Configurations:")
+	tblPrinter := printer.NewTablePrinter(o.Out)
+	tblPrinter.SetHeader("KIND", "NAME", "WEBHOOKS", "CREATED-AT")
+	selectors := []metav1.ListOptions{{LabelSelector: buildKubeBlocksSelectorLabels()}}
+	gvrs := []schema.GroupVersionResource{types.ValidatingWebhookConfigurationGVR(), types.MutatingWebhookConfigurationGVR()}
+	for _, list := range util.ListResourceByGVR(ctx, o.dynamic, metav1.NamespaceAll, gvrs, selectors, allErrs) {
+		for _, item := range list.Items {
+			webhooks, _, _ := unstructured.NestedSlice(item.Object, "webhooks")
+			createdAt := item.GetCreationTimestamp()
+			tblPrinter.AddRow(item.GetKind(), item.GetName(), len(webhooks), util.TimeFormat(&createdAt))
+		}
+	}
+	tblPrinter.Print()
+}
+
+// showUnavailableClusterDefs shows the cluster definitions which are not reconciled to be available
+func (o *statusOptions) showUnavailableClusterDefs(ctx context.Context, allErrs *[]error) {
+	objs, err := o.dynamic.Resource(types.ClusterDefGVR()).List(ctx, metav1.ListOptions{})
+	util.AppendErrIgnoreNotFound(allErrs, err)
+	if objs == nil {
+		return
+	}
+	tblPrinter := printer.NewTablePrinter(o.Out)
+	tblPrinter.SetHeader("NAME", "STATUS", "MESSAGE")
+	for _, obj := range objs.Items {
+		phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+		if phase == string(appsv1alpha1.AvailablePhase) {
+			continue
+		}
+		message, _, _ := unstructured.NestedString(obj.Object, "status", "message")
+		tblPrinter.AddRow(obj.GetName(), util.CheckEmpty(phase), util.CheckEmpty(message))
+	}
+	if tblPrinter.Tbl.Length() == 0 {
+		return
+	}
+	fmt.Fprintln(o.Out, "\nUnavailable Cluster Definitions:")
+	tblPrinter.Print()
+}
+
 func (o *statusOptions) buildSelectorList(ctx context.Context, allErrs *[]error) {
 	addons := make([]*extensionsv1alpha1.Addon, 0)
 	objs, err := o.dynamic.Resource(types.AddonGVR()).List(ctx, metav1.ListOptions{})
@@ -178,4 +178,20 @@ var _ = Describe("kubeblocks status", func() {
 		}
 		Expect(o.run()).To(Succeed())
 	})
+
+	It("show operator and cluster definitions", func() {
+		out := &bytes.Buffer{}
+		o := &statusOptions{
+			IOStreams: genericiooptions.IOStreams{Out: out, ErrOut: out},
+			ns:        namespace,
+			client:    testing.FakeClientSet(),
+			dynamic:   testing.FakeDynamicClient(testing.FakeClusterDef()),
+		}
+		allErrs := make([]error, 0)
+		Expect(o.showOperatorPods(context.Background(), &allErrs)).Should(BeFalse())
+		o.showUnavailableClusterDefs(context.Background(), &allErrs)
+		Expect(allErrs).Should(BeEmpty())
+		Expect(out.String()).Should(ContainSubstring("Unavailable Cluster Definitions"))
+		Expect(out.String()).Should(ContainSubstring(testing.ClusterDefName))
+	})
 })
@@ -51,12 +51,16 @@ import (
 	"github.com/apecloud/kbcli/pkg/types"
 	"github.com/apecloud/kbcli/pkg/util"
 	"github.com/apecloud/kbcli/pkg/util/helm"
+	"github.com/apecloud/kbcli/pkg/util/prompt"
 )
 
 var (
 	uninstallExample = templates.Examples(`
 		# uninstall KubeBlocks
-        kbcli kubeblocks uninstall`)
+        kbcli kubeblocks uninstall
+
+		# uninstall KubeBlocks and delete all clusters, but keep the KubeBlocks CRDs
+        kbcli kubeblocks uninstall --remove-clusters --remove-crds=false`)
 )
 
 type UninstallOptions struct {
@@ -68,6 +72,8 @@ type UninstallOptions struct {
 	removePVs       bool
 	removePVCs      bool
 	RemoveNamespace bool
+	removeCRDs      bool
+	removeClusters  bool
 	addons          []*extensionsv1alpha1.Addon
 	Quiet           bool
 	force           bool
@@ -78,8 +84,9 @@ func newUninstallCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cob
 		Options: Options{
 			IOStreams: streams,
 		},
-		Factory: f,
-		force:   true,
+		Factory:    f,
+		force:      true,
+		removeCRDs: true,
 	}
 	cmd := &cobra.Command{
 		Use:     "uninstall",
@@ -97,6 +104,8 @@ func newUninstallCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cob
 	cmd.Flags().BoolVar(&o.removePVs, "remove-pvs", false, "Remove PersistentVolume or not")
 	cmd.Flags().BoolVar(&o.removePVCs, "remove-pvcs", false, "Remove PersistentVolumeClaim or not")
 	cmd.Flags().BoolVar(&o.RemoveNamespace, "remove-namespace", false, "Remove default created \"kb-system\" namespace or not")
+	cmd.Flags().BoolVar(&o.removeCRDs, "remove-crds", o.removeCRDs, "Remove KubeBlocks CRDs or not")
+	cmd.Flags().BoolVar(&o.removeClusters, "remove-clusters", false, "Delete all clusters before uninstalling KubeBlocks, it will prompt for confirmation unless --auto-approve is set")
 	cmd.Flags().DurationVar(&o.Timeout, "timeout", 300*time.Second, "Time to wait for uninstalling KubeBlocks, such as --timeout=5m")
 	cmd.Flags().BoolVar(&o.Wait, "wait", true, "Wait for KubeBlocks to be uninstalled, including all the add-ons. It will wait for a --timeout period")
 	return cmd
@@ -111,6 +120,13 @@ func (o *UninstallOptions) PreCheck() error {
 		}
 	}
 
+	// delete all clusters if --remove-clusters is set
+	if o.removeClusters {
+		if err := o.deleteClusters(); err != nil {
+			return err
+		}
+	}
+
 	// check if there is any resource should be removed first, if so, return error
 	// and ask user to remove them manually
 	if err := checkResources(o.Dynamic); err != nil {
@@ -199,6 +215,9 @@ func (o *UninstallOptions) Uninstall() error {
 		if gvr == types.PVGVR() && !o.removePVs {
 			continue
 		}
+		if gvr == types.CRDGVR() && !o.removeCRDs {
+			continue
+		}
 		if v, ok := objs[gvr]; !ok || len(v.Items) == 0 {
 			continue
 		}
@@ -212,6 +231,12 @@ func (o *UninstallOptions) Uninstall() error {
 	}
 
 	if o.Wait {
+		s := newSpinner("Wait for KubeBlocks pods to be deleted")
+		if err = o.waitPodsDeleted(); err != nil {
+			s.Fail()
+			return fmt.Errorf("failed to wait for KubeBlocks pods to be deleted: %v", err)
+		}
+		s.Success()
 		fmt.Fprintln(o.Out, "Uninstall KubeBlocks done.")
 	} else {
 		fmt.Fprintf(o.Out, "KubeBlocks is uninstalling, run \"kbcli kubeblocks status -A\" to check kubeblocks resources.\n")
@@ -219,6 +244,62 @@ func (o *UninstallOptions) Uninstall() error {
 	return nil
 }
 
+// deleteClusters deletes all clusters after the user confirms, and waits for them to be deleted
+func (o *UninstallOptions) deleteClusters() error {
+	clusters, err := o.Dynamic.Resource(types.ClusterGVR()).Namespace(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if len(clusters.Items) == 0 {
+		return nil
+	}
+
+	var names []string
+	for _, c := range clusters.Items {
+		names = append(names, c.GetNamespace()+"/"+c.GetName())
+	}
+	if !o.AutoApprove {
+		printer.Warning(o.Out, "the following clusters will be deleted: %s\n", strings.Join(names, " "))
+		if err = prompt.Confirm(nil, o.In, "", "Please type \"yes\" to confirm:"); err != nil {
+			return err
+		}
+	}
+
+	s := spinner.New(o.Out, spinner.WithMessage(fmt.Sprintf("%-50s", "Delete clusters")))
+	for _, c := range clusters.Items {
+		if err = o.Dynamic.Resource(types.ClusterGVR()).Namespace(c.GetNamespace()).Delete(context.TODO(), c.GetName(), metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			s.Fail()
+			return err
+		}
+	}
+	if err = wait.PollUntilContextTimeout(context.Background(), 5*time.Second, o.Timeout, true, func(ctx context.Context) (bool, error) {
+		list, err := o.Dynamic.Resource(types.ClusterGVR()).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return false, err
+		}
+		return len(list.Items) == 0, nil
+	}); err != nil {
+		s.Fail()
+		return fmt.Errorf("failed to wait for clusters to be deleted, run \"kbcli cluster list -A\" to check the clusters: %v", err)
+	}
+	s.Success()
+	return nil
+}
+
+// waitPodsDeleted waits for all KubeBlocks operator pods to be deleted
+func (o *UninstallOptions) waitPodsDeleted() error {
+	return wait.PollUntilContextTimeout(context.Background(), 5*time.Second, o.Timeout, true, func(ctx context.Context) (bool, error) {
+		pods, err := o.Client.CoreV1().Pods(o.Namespace).List(ctx, metav1.ListOptions{LabelSelector: buildKubeBlocksSelectorLabels()})
+		if err != nil {
+			return false, err
+		}
+		return len(pods.Items) == 0, nil
+	})
+}
+
 // uninstallAddons uninstalls all KubeBlocks addons
 func (o *UninstallOptions) uninstallAddons() error {
 	var (
@@ -20,6 +20,8 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package kubeblocks
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -79,6 +81,23 @@ var _ = Describe("kubeblocks uninstall", func() {
 		Expect(o.Uninstall()).Should(Succeed())
 	})
 
+	It("remove clusters and wait for pods deleted", func() {
+		o := UninstallOptions{
+			Options: Options{
+				IOStreams: streams,
+				Namespace: namespace,
+				Client:    testing.FakeClientSet(),
+				Dynamic:   testing.FakeDynamicClient(testing.FakeCluster("test", testing.Namespace)),
+				Timeout:   time.Second,
+			},
+			AutoApprove: true,
+		}
+		Expect(checkResources(o.Dynamic)).Should(HaveOccurred())
+		Expect(o.deleteClusters()).Should(Succeed())
+		Expect(checkResources(o.Dynamic)).Should(Succeed())
+		Expect(o.waitPodsDeleted()).Should(Succeed())
+	})
+
 	It("checkResources", func() {
 		fakeDynamic := testing.FakeDynamicClient()
 		Expect(checkResources(fakeDynamic)).Should(Succeed())
@@ -20,6 +20,7 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package kubeblocks
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -237,6 +238,12 @@ func (o *InstallOptions) Upgrade() error {
 		s.Success()
 	}
 
+	// collect the difference of chart default values when upgrading to a new version,
+	// and print it after the spinner is stopped
+	valuesDiff := &bytes.Buffer{}
+	if o.Version != "" {
+		o.valuesDiff = valuesDiff
+	}
 	s = spinner.New(o.Out, spinnerMsg("Upgrading KubeBlocks "+msg))
 	defer s.Fail()
 	// upgrade KubeBlocks chart
@@ -247,6 +254,9 @@ func (o *InstallOptions) Upgrade() error {
 	s.Success()
 
 	if !o.Quiet {
+		if valuesDiff.Len() > 0 {
+			fmt.Fprintf(o.Out, "\nChanges of the chart default values:\n%s", valuesDiff.String())
+		}
 		fmt.Fprintf(o.Out, "\nKubeBlocks has been upgraded %s SUCCESSFULLY!\n", msg)
 		o.printNotes()
 	}
@@ -35,8 +35,10 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/exp/slices"
 	"helm.sh/helm/v3/pkg/repo"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/util/yaml"
@@ -404,3 +406,33 @@ func createOrUpdateCRDS(dynamic dynamic.Interface, kbVersion string) error {
 	}
 	return nil
 }
+
+// printKubeBlocksCRDs prints the KubeBlocks CRDs and their served versions
+func printKubeBlocksCRDs(ctx context.Context, dynamic dynamic.Interface, out io.Writer) {
+	tbl := printer.NewTablePrinter(out)
+	tbl.SetHeader("NAME", "KIND", "VERSIONS")
+	crds, err := dynamic.Resource(types.CRDGVR()).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		klog.V(1).Infof("failed to list CRDs: %v", err)
+	}
+	if crds != nil {
+		for _, item := range crds.Items {
+			if !strings.Contains(item.GetName(), constant.APIGroup) {
+				continue
+			}
+			crd := &apiextensionsv1.CustomResourceDefinition{}
+			if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, crd); err != nil {
+				klog.V(1).Infof("failed to convert CRD %s: %v", item.GetName(), err)
+				continue
+			}
+			var versions []string
+			for _, v := range crd.Spec.Versions {
+				if v.Served {
+					versions = append(versions, v.Name)
+				}
+			}
+			tbl.AddRow(crd.Name, crd.Spec.Names.Kind, strings.Join(versions, ","))
+		}
+	}
+	tbl.Print()
+}
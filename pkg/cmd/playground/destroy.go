@@ -42,6 +42,7 @@ import (
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
 
 	cp "github.com/apecloud/kbcli/pkg/cloudprovider"
+	"github.com/apecloud/kbcli/pkg/cmd/dataprotection"
 	"github.com/apecloud/kbcli/pkg/cmd/kubeblocks"
 	"github.com/apecloud/kbcli/pkg/printer"
 	"github.com/apecloud/kbcli/pkg/spinner"
@@ -54,7 +55,10 @@ import (
 var (
 	destroyExample = templates.Examples(`
 		# destroy playground cluster
-		kbcli playground destroy`)
+		kbcli playground destroy
+
+		# export the completed backups to the local directory ./backups before destroying playground cluster
+		kbcli playground destroy --export-backups ./backups`)
 )
 
 type destroyOptions struct {
@@ -67,6 +71,8 @@ type destroyOptions struct {
 	purge       bool
 	// timeout represents the timeout for the destruction process.
 	timeout time.Duration
+	// exportBackupsDir is the local directory to export the completed backups to before destroying
+	exportBackupsDir string
 }
 
 func newDestroyCmd(streams genericiooptions.IOStreams) *cobra.Command {
@@ -87,6 +93,7 @@ func newDestroyCmd(streams genericiooptions.IOStreams) *cobra.Command {
 	cmd.Flags().BoolVar(&o.purge, "purge", true, "Purge all resources before destroying kubernetes cluster, delete all clusters created by KubeBlocks and uninstall KubeBlocks.")
 	cmd.Flags().DurationVar(&o.timeout, "timeout", 300*time.Second, "Time to wait for destroying KubeBlocks, such as --timeout=10m")
 	cmd.Flags().BoolVar(&o.autoApprove, "auto-approve", false, "Skip interactive approval before destroying the playground")
+	cmd.Flags().StringVar(&o.exportBackupsDir, "export-backups", "", "The local directory to export the completed backups to before destroying the playground, only backups in S3-compatible backup repos are exported, the backups in PVC-backed repos are skipped with a warning. The playground is not destroyed if no backup is exported")
 	return cmd
 }
 
@@ -95,12 +102,45 @@ func (o *destroyOptions) destroy() error {
 		return fmt.Errorf("no playground cluster found")
 	}
 
+	if err := o.exportBackups(); err != nil {
+		return err
+	}
+
 	if o.prevCluster.CloudProvider == cp.Local {
 		return o.destroyLocal()
 	}
 	return o.destroyCloud()
 }
 
+// exportBackups exports the completed backups in the playground cluster to the local directory
+// if --export-backups is specified, the playground will not be destroyed if it fails.
+func (o *destroyOptions) exportBackups() error {
+	if o.exportBackupsDir == "" {
+		return nil
+	}
+	if o.prevCluster.KubeConfig == "" {
+		return fmt.Errorf("no kubeconfig found for kubernetes cluster %s in %s, can not export backups",
+			o.prevCluster.ClusterName, o.stateFilePath)
+	}
+	if err := writeAndUseKubeConfig(o.prevCluster.KubeConfig, o.kubeConfigPath, o.Out); err != nil {
+		return err
+	}
+	client, dynamic, err := getKubeClient()
+	if err != nil {
+		return err
+	}
+	exported, err := dataprotection.ExportCompletedBackups(client, dynamic, types.BackupGVR(), o.IOStreams, o.exportBackupsDir)
+	if err != nil {
+		return err
+	}
+	// the backups in PVC-backed backup repos can not be exported, do not destroy
+	// the playground silently if the users expect their backups to be kept
+	if exported == 0 {
+		return fmt.Errorf("no backup is exported to %s, the playground is not destroyed, destroy it without --export-backups if the backups are not needed", o.exportBackupsDir)
+	}
+	return nil
+}
+
 // destroyLocal destroy local k3d cluster that will destroy all resources
 func (o *destroyOptions) destroyLocal() error {
 	provider, _ := cp.New(cp.Local, "", o.Out, o.ErrOut)
@@ -24,6 +24,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	cp "github.com/apecloud/kbcli/pkg/cloudprovider"
 )
 
 var _ = Describe("playground destroy", func() {
@@ -41,4 +43,17 @@ var _ = Describe("playground destroy", func() {
 		}
 		Expect(o.destroy()).Should(HaveOccurred())
 	})
+
+	It("export backups", func() {
+		o := &destroyOptions{
+			IOStreams:   streams,
+			baseOptions: baseOptions{prevCluster: &cp.K8sClusterInfo{ClusterName: "test"}},
+		}
+		By("skip exporting if --export-backups is not specified")
+		Expect(o.exportBackups()).Should(Succeed())
+
+		By("return error if the kubeconfig is not found")
+		o.exportBackupsDir = GinkgoT().TempDir()
+		Expect(o.exportBackups()).Should(MatchError(ContainSubstring("can not export backups")))
+	})
 })
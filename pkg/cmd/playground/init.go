@@ -60,6 +60,9 @@ on the created kubernetes cluster, and an apecloud-mysql cluster named mycluster
 		# create a k3d cluster on local host and install KubeBlocks
 		kbcli playground init
 
+		# create a k3d cluster with the specified kubernetes version on local host and install KubeBlocks
+		kbcli playground init --k8s-version v1.26.4
+
 		# create an AWS EKS cluster and install KubeBlocks, the region is required
 		kbcli playground init --cloud-provider aws --region us-west-1
 
@@ -103,6 +106,7 @@ type initOptions struct {
 	clusterVersion string
 	cloudProvider  string
 	region         string
+	k8sVersion     string
 	autoApprove    bool
 	dockerVersion  *gv.Version
 
@@ -131,6 +135,7 @@ func newInitCmd(streams genericiooptions.IOStreams) *cobra.Command {
 	cmd.Flags().StringVar(&o.kbVersion, "version", version.DefaultKubeBlocksVersion, "KubeBlocks version")
 	cmd.Flags().StringVar(&o.cloudProvider, "cloud-provider", defaultCloudProvider, fmt.Sprintf("Cloud provider type, one of %v", supportedCloudProviders))
 	cmd.Flags().StringVar(&o.region, "region", "", "The region to create kubernetes cluster")
+	cmd.Flags().StringVar(&o.k8sVersion, "k8s-version", "", "The kubernetes version of the local k3d cluster, such as v1.26.4, default to "+version.K3sImageTag)
 	cmd.Flags().DurationVar(&o.Timeout, "timeout", 300*time.Second, "Time to wait for init playground, such as --timeout=10m")
 	cmd.Flags().BoolVar(&o.autoApprove, "auto-approve", false, "Skip interactive approval during the initialization of playground")
 
@@ -169,6 +174,15 @@ func (o *initOptions) validate() error {
 		return fmt.Errorf("region should be specified when cloud provider %s is specified", o.cloudProvider)
 	}
 
+	if o.k8sVersion != "" {
+		if o.cloudProvider != cp.Local {
+			return fmt.Errorf("--k8s-version is only supported by the local cloud provider")
+		}
+		if _, err := gv.NewVersion(o.k8sVersion); err != nil {
+			return fmt.Errorf("invalid kubernetes version %s: %v", o.k8sVersion, err)
+		}
+	}
+
 	if o.clusterDef == "" {
 		return fmt.Errorf("a valid cluster definition is needed, use --cluster-definition to specify one")
 	}
@@ -206,6 +220,7 @@ func (o *initOptions) local() error {
 		clusterInfo = &cp.K8sClusterInfo{
 			CloudProvider: provider.Name(),
 			ClusterName:   types.K3dClusterName,
+			K8sVersion:    o.k8sVersion,
 		}
 	}
 
@@ -88,4 +88,25 @@ var _ = Describe("playground", func() {
 		}
 		Expect(o.validate()).Should(HaveOccurred())
 	})
+
+	It("init with kubernetes version", func() {
+		o := &initOptions{
+			clusterDef:     clitesting.ClusterDefName,
+			clusterVersion: clitesting.ClusterVersionName,
+			IOStreams:      streams,
+			cloudProvider:  defaultCloudProvider,
+			helmCfg:        helm.NewConfig("", testKubeConfigPath, "", false),
+			dockerVersion:  version.MinimumDockerVersion,
+			k8sVersion:     "v1.26.4",
+		}
+		Expect(o.validate()).Should(Succeed())
+
+		o.k8sVersion = "invalid"
+		Expect(o.validate()).Should(MatchError(ContainSubstring("invalid kubernetes version")))
+
+		o.k8sVersion = "v1.26.4"
+		o.cloudProvider = cp.AWS
+		o.region = "us-west-1"
+		Expect(o.validate()).Should(MatchError(ContainSubstring("only supported by the local cloud provider")))
+	})
 })
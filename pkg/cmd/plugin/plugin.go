@@ -65,6 +65,7 @@ func NewPluginCmd(streams genericiooptions.IOStreams) *cobra.Command {
 
 	cmd.AddCommand(
 		NewPluginListCmd(streams),
+		NewPluginRunCmd(streams),
 		NewPluginIndexCmd(streams),
 		NewPluginInstallCmd(streams),
 		NewPluginUninstallCmd(streams),
@@ -0,0 +1,90 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+	utilexec "k8s.io/utils/exec"
+)
+
+var pluginRunExample = templates.Examples(`
+	# Run the plugin executable kbcli-foo or kubectl-foo on a user's PATH
+	kbcli plugin run foo
+
+	# Run a plugin with arguments, the flags after the plugin name are passed to the plugin
+	kbcli plugin run foo --name bar
+	`)
+
+func NewPluginRunCmd(streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "run NAME [ARGS...]",
+		DisableFlagsInUseLine: true,
+		Short:                 "Run a plugin executable on a user's PATH",
+		Example:               pluginRunExample,
+		Args:                  cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(runPlugin(streams, args[0], args[1:]))
+		},
+	}
+	// pass the flags after the plugin name to the plugin
+	cmd.Flags().SetInterspersed(false)
+	return cmd
+}
+
+// lookupPlugin finds the plugin executable named with one of the valid prefixes on a user's PATH,
+// the plugins installed by kbcli are also found since their directory is put into the PATH.
+func lookupPlugin(name string) (string, error) {
+	for _, prefix := range ValidPluginFilenamePrefixes {
+		if path, err := exec.LookPath(fmt.Sprintf("%s-%s", prefix, name)); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("plugin %s not found, run \"kbcli plugin list\" to show all available plugins", name)
+}
+
+// runPlugin runs the plugin with the given arguments, and returns an exit error with the exit code
+// of the plugin if it fails.
+func runPlugin(streams genericiooptions.IOStreams, name string, args []string) error {
+	path, err := lookupPlugin(name)
+	if err != nil {
+		return err
+	}
+	c := exec.Command(path, args...)
+	c.Stdin = streams.In
+	c.Stdout = streams.Out
+	c.Stderr = streams.ErrOut
+	c.Env = os.Environ()
+	if err = c.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return utilexec.CodeExitError{Err: err, Code: exitErr.ExitCode()}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	utilexec "k8s.io/utils/exec"
+)
+
+func TestRunPlugin(t *testing.T) {
+	tempDir := t.TempDir()
+	script := "#!/bin/sh\necho \"args: $*\"\nexit ${EXIT_CODE:-0}\n"
+	if err := os.WriteFile(filepath.Join(tempDir, "kbcli-hello"), []byte(script), 0755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Setenv("PATH", tempDir)
+
+	ioStreams, _, out, _ := genericiooptions.NewTestIOStreams()
+	if err := runPlugin(ioStreams, "hello", []string{"world", "--name", "foo"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out.String(), "args: world --name foo") {
+		t.Fatalf("unexpected output: %s", out.String())
+	}
+
+	// the exit code of the plugin is returned
+	t.Setenv("EXIT_CODE", "3")
+	err := runPlugin(ioStreams, "hello", nil)
+	exitErr, ok := err.(utilexec.ExitError)
+	if !ok || exitErr.ExitStatus() != 3 {
+		t.Fatalf("expected exit error with code 3, but got %v", err)
+	}
+
+	if err = runPlugin(ioStreams, "not-exist", nil); err == nil || !strings.Contains(err.Error(), "plugin not-exist not found") {
+		t.Fatalf("expected not found error, but got %v", err)
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package storageprovider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	storagev1alpha1 "github.com/apecloud/kubeblocks/apis/storage/v1alpha1"
+
+	"github.com/apecloud/kbcli/pkg/action"
+	"github.com/apecloud/kbcli/pkg/cmd/backuprepo"
+	"github.com/apecloud/kbcli/pkg/printer"
+	"github.com/apecloud/kbcli/pkg/types"
+	"github.com/apecloud/kbcli/pkg/util"
+)
+
+var listExample = templates.Examples(`
+	# list all storage providers
+	kbcli storageprovider list`)
+
+func NewStorageProviderCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "storageprovider COMMAND",
+		Short:   "StorageProvider command.",
+		Aliases: []string{"sp"},
+	}
+	cmd.AddCommand(newListCmd(f, streams))
+	return cmd
+}
+
+func newListCmd(f cmdutil.Factory, streams genericiooptions.IOStreams) *cobra.Command {
+	o := action.NewListOptions(f, streams, types.StorageProviderGVR())
+	cmd := &cobra.Command{
+		Use:               "list",
+		Short:             "List StorageProviders.",
+		Aliases:           []string{"ls"},
+		Example:           listExample,
+		ValidArgsFunction: util.ResourceNameCompletionFunc(f, types.StorageProviderGVR()),
+		Run: func(cmd *cobra.Command, args []string) {
+			o.Names = args
+			cmdutil.BehaviorOnFatal(printer.FatalWithRedColor)
+			util.CheckErr(printStorageProviderList(o))
+		},
+	}
+	o.AddFlags(cmd, true)
+	return cmd
+}
+
+func printStorageProviderList(o *action.ListOptions) error {
+	dynamic, err := o.Factory.DynamicClient()
+	if err != nil {
+		return err
+	}
+	listOpts := metav1.ListOptions{
+		LabelSelector: o.LabelSelector,
+		FieldSelector: o.FieldSelector,
+	}
+	providerList, err := dynamic.Resource(types.StorageProviderGVR()).List(context.TODO(), listOpts)
+	legacy := apierrors.IsNotFound(err)
+	if legacy {
+		// the storage providers are served by the legacy storage.kubeblocks.io API before KubeBlocks 0.8
+		providerList, err = dynamic.Resource(types.LegacyStorageProviderGVR()).List(context.TODO(), listOpts)
+	}
+	if err != nil {
+		return err
+	}
+
+	// if format is JSON, YAML or template, use default printer to output the result.
+	if o.Format == printer.JSON || o.Format == printer.YAML || o.Format == printer.Template {
+		if legacy {
+			o.GVR = types.LegacyStorageProviderGVR()
+		}
+		_, err = o.Run()
+		return err
+	}
+
+	// the backup repos using each storage provider
+	repoList, err := dynamic.Resource(types.BackupRepoGVR()).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	providerRepos := map[string][]string{}
+	for _, item := range repoList.Items {
+		repo := &dpv1alpha1.BackupRepo{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, repo); err != nil {
+			return err
+		}
+		providerRepos[repo.Spec.StorageProviderRef] = append(providerRepos[repo.Spec.StorageProviderRef], repo.Name)
+	}
+
+	names := map[string]bool{}
+	for _, name := range o.Names {
+		names[name] = true
+	}
+	var providers []*dpv1alpha1.StorageProvider
+	for _, item := range providerList.Items {
+		if len(names) > 0 && !names[item.GetName()] {
+			continue
+		}
+		if legacy {
+			provider := &storagev1alpha1.StorageProvider{}
+			if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, provider); err != nil {
+				return err
+			}
+			providers = append(providers, backuprepo.ConvertLegacyStorageProvider(provider))
+			continue
+		}
+		provider := &dpv1alpha1.StorageProvider{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, provider); err != nil {
+			return err
+		}
+		providers = append(providers, provider)
+	}
+	if len(providers) == 0 {
+		o.PrintNotFoundResources()
+		return nil
+	}
+	sort.Slice(providers, func(i, j int) bool {
+		return providers[i].Name < providers[j].Name
+	})
+
+	tbl := printer.NewTablePrinter(o.Out)
+	tbl.SetHeader("NAME", "ACCESS-METHODS", "CSI-DRIVER", "STATUS", "BACKUP-REPOS")
+	for _, provider := range providers {
+		csiDriver := provider.Spec.CSIDriverName
+		if csiDriver == "" {
+			csiDriver = "-"
+		}
+		repos := strings.Join(providerRepos[provider.Name], ",")
+		if repos == "" {
+			repos = "-"
+		}
+		tbl.AddRow(provider.Name, strings.Join(accessMethods(provider), ","), csiDriver, provider.Status.Phase, repos)
+	}
+	tbl.Print()
+	return nil
+}
+
+// accessMethods returns the access methods supported by the storage provider, the backup repo
+// can mount the storage by a PVC, or access it by the datasafed tool directly.
+func accessMethods(provider *dpv1alpha1.StorageProvider) []string {
+	var methods []string
+	if provider.Spec.StorageClassTemplate != "" || provider.Spec.PersistentVolumeClaimTemplate != "" {
+		methods = append(methods, string(dpv1alpha1.AccessMethodMount))
+	}
+	if provider.Spec.DatasafedConfigTemplate != "" {
+		methods = append(methods, string(dpv1alpha1.AccessMethodTool))
+	}
+	return methods
+}
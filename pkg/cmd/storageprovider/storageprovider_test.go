@@ -0,0 +1,99 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package storageprovider
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	clienttesting "k8s.io/client-go/testing"
+	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	storagev1alpha1 "github.com/apecloud/kubeblocks/apis/storage/v1alpha1"
+
+	"github.com/apecloud/kbcli/pkg/testing"
+	"github.com/apecloud/kbcli/pkg/types"
+)
+
+var _ = Describe("storageprovider command", func() {
+	var (
+		streams genericiooptions.IOStreams
+		out     *bytes.Buffer
+		tf      *cmdtesting.TestFactory
+	)
+
+	BeforeEach(func() {
+		streams, _, out, _ = genericiooptions.NewTestIOStreams()
+		tf = cmdtesting.NewTestFactory().WithNamespace(testing.Namespace)
+		provider := testing.FakeStorageProvider("fake-storage-provider", func(obj *dpv1alpha1.StorageProvider) {
+			obj.Spec.DatasafedConfigTemplate = "provider=s3"
+			obj.Status.Phase = dpv1alpha1.StorageProviderReady
+		})
+		tf.FakeDynamicClient = testing.FakeDynamicClient(provider, testing.FakeStorageProvider("unused-provider", nil),
+			testing.FakeBackupRepo("default-backuprepo", true))
+	})
+
+	AfterEach(func() {
+		tf.Cleanup()
+	})
+
+	It("list storage providers", func() {
+		Expect(NewStorageProviderCmd(tf, streams)).ShouldNot(BeNil())
+		cmd := newListCmd(tf, streams)
+		cmd.Run(cmd, nil)
+		Expect(out.String()).Should(ContainSubstring("fake-storage-provider"))
+		Expect(out.String()).Should(ContainSubstring("Mount,Tool"))
+		Expect(out.String()).Should(ContainSubstring("default-backuprepo"))
+		Expect(out.String()).Should(ContainSubstring("unused-provider"))
+
+		By("list the specified storage provider")
+		out.Reset()
+		cmd.Run(cmd, []string{"unused-provider"})
+		Expect(out.String()).ShouldNot(ContainSubstring("fake-storage-provider"))
+	})
+
+	It("list the legacy storage providers", func() {
+		legacyProvider := &storagev1alpha1.StorageProvider{
+			ObjectMeta: metav1.ObjectMeta{Name: "legacy-provider"},
+			Spec:       storagev1alpha1.StorageProviderSpec{CSIDriverName: "legacy-csi-driver"},
+			Status:     storagev1alpha1.StorageProviderStatus{Phase: storagev1alpha1.StorageProviderReady},
+		}
+		tf.FakeDynamicClient = testing.FakeDynamicClient(legacyProvider, testing.FakeBackupRepo("default-backuprepo", true))
+		// the storage providers of the dataprotection.kubeblocks.io API are not served
+		tf.FakeDynamicClient.PrependReactor("list", types.ResourceStorageProviders, func(action clienttesting.Action) (bool, runtime.Object, error) {
+			if action.GetResource().Group != types.DPAPIGroup {
+				return false, nil, nil
+			}
+			return true, nil, apierrors.NewNotFound(types.StorageProviderGVR().GroupResource(), "")
+		})
+		cmd := newListCmd(tf, streams)
+		cmd.Run(cmd, nil)
+		Expect(out.String()).Should(ContainSubstring("legacy-provider"))
+		Expect(out.String()).Should(ContainSubstring("legacy-csi-driver"))
+		Expect(out.String()).Should(ContainSubstring("Ready"))
+		Expect(out.String()).ShouldNot(ContainSubstring("fake-storage-provider"))
+	})
+})
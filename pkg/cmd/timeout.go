@@ -0,0 +1,79 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cmd
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const timeoutFlag = "timeout"
+
+// setTimeoutContext wraps the context of the command with the deadline of the global --timeout
+// flag and returns the deadline, the commands with their own --timeout flag are not affected.
+func setTimeoutContext(cmd *cobra.Command, timeout time.Duration) time.Time {
+	if timeout <= 0 || cmd.Flag(timeoutFlag) != cmd.Root().PersistentFlags().Lookup(timeoutFlag) {
+		return time.Time{}
+	}
+	deadline := time.Now().Add(timeout)
+	ctx, cancel := context.WithDeadline(cmd.Context(), deadline)
+	cobra.OnFinalize(cancel)
+	cmd.SetContext(ctx)
+	return deadline
+}
+
+// deadlineRoundTripper applies the deadline of the global --timeout flag to the requests sent
+// to the API server, most commands do not pass the command context to the clients, so the
+// deadline of the command context alone does not stop them.
+type deadlineRoundTripper struct {
+	deadline func() time.Time
+	rt       http.RoundTripper
+}
+
+func (t *deadlineRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	deadline := t.deadline()
+	if deadline.IsZero() {
+		return t.rt.RoundTrip(req)
+	}
+	ctx, cancel := context.WithDeadline(req.Context(), deadline)
+	resp, err := t.rt.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// the body of response is read after RoundTrip returns, such as the events of a watch,
+	// so the context is canceled only when the body is closed
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
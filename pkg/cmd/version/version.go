@@ -20,7 +20,10 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package version
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"reflect"
 	"runtime"
 
@@ -28,66 +31,130 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/klog/v2"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"sigs.k8s.io/yaml"
 
+	"github.com/apecloud/kbcli/pkg/printer"
 	"github.com/apecloud/kbcli/pkg/util"
 	"github.com/apecloud/kbcli/version"
 )
 
 type versionOptions struct {
 	verbose bool
+	format  printer.Format
+	out     io.Writer
+}
+
+// versionInfo is the machine-readable version information
+type versionInfo struct {
+	Kubernetes string     `json:"kubernetes,omitempty"`
+	KubeBlocks string     `json:"kubeblocks,omitempty"`
+	Cli        cliVersion `json:"kbcli"`
+}
+
+type cliVersion struct {
+	Version   string `json:"version"`
+	GitCommit string `json:"gitCommit"`
+	GitTag    string `json:"gitTag"`
+	BuildDate string `json:"buildDate"`
+	GoVersion string `json:"goVersion"`
+	Compiler  string `json:"compiler"`
+	Platform  string `json:"platform"`
 }
 
 // NewVersionCmd the version command
 func NewVersionCmd(f cmdutil.Factory) *cobra.Command {
-	o := &versionOptions{}
+	o := &versionOptions{out: os.Stdout}
 	cmd := &cobra.Command{
 		Use:   "version",
 		Short: "Print the version information, include kubernetes, KubeBlocks and kbcli version.",
+		Example: `
+		# print the version information
+		kbcli version
+
+		# print the version information in JSON format
+		kbcli version --output json`,
 		Run: func(cmd *cobra.Command, args []string) {
-			o.Run(f)
+			cmdutil.CheckErr(o.Run(f))
 		},
 	}
 	cmd.Flags().BoolVar(&o.verbose, "verbose", false, "print detailed kbcli information")
+	printer.AddOutputFlag(cmd, &o.format)
 	return cmd
 }
 
-func (o *versionOptions) Run(f cmdutil.Factory) {
+func (o *versionOptions) Run(f cmdutil.Factory) error {
 	client, err := f.KubernetesClientSet()
 	if err != nil {
 		klog.V(1).Infof("failed to get clientset: %v", err)
 	}
 
 	v, _ := util.GetVersionInfo(client)
+	if !o.format.IsHumanReadable() {
+		return o.printVersionInfo(v)
+	}
+
 	if v.Kubernetes != "" {
-		fmt.Printf("Kubernetes: %s\n", v.Kubernetes)
+		fmt.Fprintf(o.out, "Kubernetes: %s\n", v.Kubernetes)
 	}
 	if v.KubeBlocks != "" {
-		fmt.Printf("KubeBlocks: %s\n", v.KubeBlocks)
+		fmt.Fprintf(o.out, "KubeBlocks: %s\n", v.KubeBlocks)
 	}
-	fmt.Printf("kbcli: %s\n", v.Cli)
+	fmt.Fprintf(o.out, "kbcli: %s\n", v.Cli)
 	if o.verbose {
-		fmt.Printf("  BuildDate: %s\n", version.BuildDate)
-		fmt.Printf("  GitCommit: %s\n", version.GitCommit)
-		fmt.Printf("  GitTag: %s\n", version.GitVersion)
-		fmt.Printf("  GoVersion: %s\n", runtime.Version())
-		fmt.Printf("  Compiler: %s\n", runtime.Compiler)
-		fmt.Printf("  Platform: %s/%s\n", runtime.GOOS, runtime.GOARCH)
+		fmt.Fprintf(o.out, "  BuildDate: %s\n", version.BuildDate)
+		fmt.Fprintf(o.out, "  GitCommit: %s\n", version.GitCommit)
+		fmt.Fprintf(o.out, "  GitTag: %s\n", version.GitVersion)
+		fmt.Fprintf(o.out, "  GoVersion: %s\n", runtime.Version())
+		fmt.Fprintf(o.out, "  Compiler: %s\n", runtime.Compiler)
+		fmt.Fprintf(o.out, "  Platform: %s/%s\n", runtime.GOOS, runtime.GOARCH)
 	}
 
 	kbVersion, err := gv.NewVersion(v.KubeBlocks)
 	if err != nil {
 		klog.V(1).Infof("failed to parse KubeBlocks version: %v", err)
-		return
+		return nil
 	}
 	cliVersion, err := gv.NewVersion(v.Cli)
 	if err != nil {
 		klog.V(1).Infof("failed to parse kbcli version: %v", err)
-		return
+		return nil
 	}
 
 	if !checkVersionMatch(kbVersion, cliVersion) {
-		fmt.Printf("WARNING: version difference between kbcli (%s) and kubeblocks (%s) \n", v.Cli, v.KubeBlocks)
+		fmt.Fprintf(o.out, "WARNING: version difference between kbcli (%s) and kubeblocks (%s) \n", v.Cli, v.KubeBlocks)
+	}
+	return nil
+}
+
+// printVersionInfo prints the version information in JSON or YAML format
+func (o *versionOptions) printVersionInfo(v util.Version) error {
+	info := versionInfo{
+		Kubernetes: v.Kubernetes,
+		KubeBlocks: v.KubeBlocks,
+		Cli: cliVersion{
+			Version:   v.Cli,
+			GitCommit: version.GitCommit,
+			GitTag:    version.GitVersion,
+			BuildDate: version.BuildDate,
+			GoVersion: runtime.Version(),
+			Compiler:  runtime.Compiler,
+			Platform:  fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH),
+		},
+	}
+	var (
+		data []byte
+		err  error
+	)
+	if o.format == printer.JSON {
+		data, err = json.MarshalIndent(info, "", "  ")
+	} else {
+		data, err = yaml.Marshal(info)
+	}
+	if err != nil {
+		return err
 	}
+	fmt.Fprintln(o.out, string(data))
+	return nil
 }
 
 func checkVersionMatch(cliVersion *gv.Version, kbVersion *gv.Version) bool {
@@ -20,12 +20,17 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package version
 
 import (
+	"bytes"
+	"encoding/json"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	gv "github.com/hashicorp/go-version"
 	"k8s.io/client-go/rest/fake"
 	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
+
+	"github.com/apecloud/kbcli/pkg/printer"
 )
 
 var _ = Describe("version", func() {
@@ -37,8 +42,18 @@ var _ = Describe("version", func() {
 		Expect(cmd).ShouldNot(BeNil())
 
 		By("testing run")
-		o := &versionOptions{}
-		o.Run(tf)
+		out := &bytes.Buffer{}
+		o := &versionOptions{out: out, format: printer.Table}
+		Expect(o.Run(tf)).Should(Succeed())
+		Expect(out.String()).Should(ContainSubstring("kbcli"))
+
+		By("testing run with json output")
+		out.Reset()
+		o.format = printer.JSON
+		Expect(o.Run(tf)).Should(Succeed())
+		info := versionInfo{}
+		Expect(json.Unmarshal(out.Bytes(), &info)).Should(Succeed())
+		Expect(info.Cli.Version).ShouldNot(BeEmpty())
 	})
 
 	It("version comparison", func() {
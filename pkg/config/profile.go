@@ -0,0 +1,126 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+
+	"github.com/apecloud/kbcli/pkg/printer"
+	"github.com/apecloud/kbcli/pkg/util"
+)
+
+// ProfilesFileName is the name of the profiles file in the kbcli home directory
+const ProfilesFileName = "profiles.yaml"
+
+// Profiles is the named connection profiles stored in the profiles file
+type Profiles struct {
+	Profiles map[string]*Profile `json:"profiles,omitempty"`
+}
+
+// Profile holds the connection settings selected by the global --profile flag
+type Profile struct {
+	// Kubeconfig is the path of the kubeconfig file, the same as --kubeconfig
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+	// Context is the kubeconfig context, the same as --context
+	Context string `json:"context,omitempty"`
+	// Namespace is the default namespace, the same as --namespace
+	Namespace string `json:"namespace,omitempty"`
+	// Output is the default output format, the same as --output
+	Output string `json:"output,omitempty"`
+}
+
+// ProfilesFilePath returns the path of the kbcli profiles file
+func ProfilesFilePath() (string, error) {
+	home, err := util.GetCliHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ProfilesFileName), nil
+}
+
+// LoadProfiles loads and validates the profiles in the profiles file, empty profiles
+// are returned if the file does not exist.
+func LoadProfiles(path string) (*Profiles, error) {
+	p := &Profiles{}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = yaml.Unmarshal(data, p); err != nil {
+		return nil, fmt.Errorf("failed to parse profiles file %s: %v", path, err)
+	}
+	if err = p.validate(); err != nil {
+		return nil, fmt.Errorf("invalid profiles file %s: %v", path, err)
+	}
+	return p, nil
+}
+
+// SaveProfiles writes the profiles to the profiles file.
+func SaveProfiles(path string, p *Profiles) error {
+	if err := p.validate(); err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(p)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// Names returns the sorted names of the profiles
+func (p *Profiles) Names() []string {
+	var names []string
+	for name := range p.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (p *Profiles) validate() error {
+	for name, profile := range p.Profiles {
+		if name == "" {
+			return fmt.Errorf("profile name can not be empty")
+		}
+		if profile == nil {
+			return fmt.Errorf("profile %q is empty", name)
+		}
+		if profile.Namespace != "" {
+			if errs := validation.IsDNS1123Label(profile.Namespace); len(errs) > 0 {
+				return fmt.Errorf("invalid namespace %q of profile %q: %v", profile.Namespace, name, errs)
+			}
+		}
+		if profile.Output != "" {
+			if _, err := printer.ParseFormat(profile.Output); err != nil {
+				return fmt.Errorf("invalid output format %q of profile %q, allowed values: %v", profile.Output, name, printer.Formats())
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("profile", func() {
+	var profilesPath string
+
+	BeforeEach(func() {
+		profilesPath = filepath.Join(GinkgoT().TempDir(), ProfilesFileName)
+	})
+
+	It("load and save profiles", func() {
+		p, err := LoadProfiles(profilesPath)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(p.Profiles).Should(BeEmpty())
+
+		p.Profiles = map[string]*Profile{"prod": {Namespace: "prod"}, "dev": {Output: "yaml"}}
+		Expect(SaveProfiles(profilesPath, p)).Should(Succeed())
+		p, err = LoadProfiles(profilesPath)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(p.Names()).Should(Equal([]string{"dev", "prod"}))
+	})
+
+	It("validate profiles", func() {
+		Expect(os.WriteFile(profilesPath, []byte("profiles:\n  dev:\n    output: xml\n"), 0600)).Should(Succeed())
+		_, err := LoadProfiles(profilesPath)
+		Expect(err).Should(MatchError(ContainSubstring("invalid output format")))
+
+		Expect(os.WriteFile(profilesPath, []byte("profiles:\n  dev:\n    namespace: Dev_NS\n"), 0600)).Should(Succeed())
+		_, err = LoadProfiles(profilesPath)
+		Expect(err).Should(MatchError(ContainSubstring("invalid namespace")))
+	})
+})
@@ -40,8 +40,17 @@ import (
 const NoneString = "<none>"
 
 func PrintAllWarningEvents(events *corev1.EventList, out io.Writer) {
-	objs := util.SortEventsByLastTimestamp(events, corev1.EventTypeWarning)
-	title := fmt.Sprintf("\n%s Events: ", corev1.EventTypeWarning)
+	printEvents(events, corev1.EventTypeWarning, fmt.Sprintf("\n%s Events: ", corev1.EventTypeWarning), out)
+}
+
+// PrintAllEvents prints all events in chronological order.
+func PrintAllEvents(events *corev1.EventList, out io.Writer) {
+	printEvents(events, "", "\nEvents: ", out)
+}
+
+// printEvents prints the events of the specified type, all events are printed if the type is empty.
+func printEvents(events *corev1.EventList, eventType string, title string, out io.Writer) {
+	objs := util.SortEventsByLastTimestamp(events, eventType)
 	if objs == nil || len(*objs) == 0 {
 		fmt.Fprintln(out, title+NoneString)
 		return
@@ -121,3 +121,20 @@ func TestPrintHelmValues(t *testing.T) {
 
 	PrintHelmValues(mockHelmConfig, JSON, out)
 }
+
+func TestPrintAllEvents(t *testing.T) {
+	eventList := &corev1.EventList{}
+	out := &bytes.Buffer{}
+	PrintAllEvents(eventList, out)
+	assert.Equal(t, "\nEvents: "+NoneString+"\n", out.String())
+
+	eventList.Items = []corev1.Event{
+		{Type: corev1.EventTypeNormal, Reason: "EventSucceed", Message: "event succeed"},
+		{Type: corev1.EventTypeWarning, Reason: "EventFailed", Message: "event failed"},
+	}
+	out.Reset()
+	PrintAllEvents(eventList, out)
+	if !clitesting.ContainExpectStrings(out.String(), "EventSucceed", "EventFailed") {
+		t.Fatal("Expect both normal and warning events in output")
+	}
+}
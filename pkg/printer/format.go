@@ -37,6 +37,10 @@ const (
 	JSON  Format = "json"
 	YAML  Format = "yaml"
 	Wide  Format = "wide"
+
+	// Template prints each object with the Go template specified by --template or -o template=<template>,
+	// it is only supported by the list commands.
+	Template Format = "template"
 )
 
 var ErrInvalidFormatType = fmt.Errorf("invalid format type")
@@ -101,6 +105,27 @@ func AddOutputFlagForCreate(cmd *cobra.Command, varRef *Format, persistent bool)
 	fs.VarP(newOutputValue(YAML, varRef), "output", "o", "Prints the output in the specified format. Allowed values: JSON and YAML")
 }
 
+// AddListOutputFlag adds the output flag which accepts the template format in addition to the formats of
+// AddOutputFlag, such as -o template='{{.metadata.name}}', and the --template flag to specify the template.
+func AddListOutputFlag(cmd *cobra.Command, varRef *Format, templateRef *string) {
+	formats := append(Formats(), Template.String())
+	cmd.Flags().VarP(newListOutputValue(Table, varRef, templateRef), "output", "o",
+		fmt.Sprintf("prints the output in the specified format. Allowed values: %s", strings.Join(formats, ", ")))
+	cmd.Flags().StringVar(templateRef, "template", "", "Template string to use when -o=template, the template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview], it is applied to the JSON representation of each object")
+	util.CheckErr(cmd.RegisterFlagCompletionFunc("output",
+		func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			descs := FormatsWithDesc()
+			descs[Template.String()] = "Output result with the Go template specified by --template"
+			var names []string
+			for format, desc := range descs {
+				if strings.HasPrefix(format, toComplete) {
+					names = append(names, fmt.Sprintf("%s\t%s", format, desc))
+				}
+			}
+			return names, cobra.ShellCompDirectiveNoFileComp
+		}))
+}
+
 type outputValue Format
 
 func newOutputValue(defaultValue Format, p *Format) *outputValue {
@@ -125,6 +150,44 @@ func (o *outputValue) Set(s string) error {
 	return nil
 }
 
+// listOutputValue is the value of output flag of the list commands, it accepts -o template=<template>
+// to specify the format and the template at the same time.
+type listOutputValue struct {
+	format   *Format
+	template *string
+}
+
+func newListOutputValue(defaultValue Format, p *Format, template *string) *listOutputValue {
+	*p = defaultValue
+	return &listOutputValue{format: p, template: template}
+}
+
+func (o *listOutputValue) String() string {
+	return string(*o.format)
+}
+
+func (o *listOutputValue) Type() string {
+	return "format"
+}
+
+func (o *listOutputValue) Set(s string) error {
+	if s == Template.String() {
+		*o.format = Template
+		return nil
+	}
+	if text, ok := strings.CutPrefix(s, Template.String()+"="); ok {
+		*o.format = Template
+		*o.template = text
+		return nil
+	}
+	outfmt, err := ParseFormat(s)
+	if err != nil {
+		return err
+	}
+	*o.format = outfmt
+	return nil
+}
+
 // FatalWithRedColor when an error occurs, sets the red color to print it.
 func FatalWithRedColor(msg string, code int) {
 	if klog.V(99).Enabled() {
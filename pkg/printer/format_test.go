@@ -55,3 +55,26 @@ func TestFormat(t *testing.T) {
 		}
 	}
 }
+
+func TestListFormat(t *testing.T) {
+	var format Format
+	var template string
+	cmd := &cobra.Command{}
+	AddListOutputFlag(cmd, &format, &template)
+	v := cmd.Flags().Lookup("output").Value
+	if v.String() != Table.String() {
+		t.Errorf("expect table format")
+	}
+	if err := v.Set("yaml"); err != nil || format != YAML {
+		t.Errorf("expect yaml format")
+	}
+	if err := v.Set("template={{.metadata.name}}"); err != nil || format != Template || template != "{{.metadata.name}}" {
+		t.Errorf("expect template format with the template")
+	}
+	if err := cmd.Flags().Set("template", "{{.kind}}"); err != nil || template != "{{.kind}}" {
+		t.Errorf("expect the template set by --template")
+	}
+	if err := v.Set("go-template"); err == nil {
+		t.Errorf("expect invalid format")
+	}
+}
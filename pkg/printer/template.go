@@ -0,0 +1,86 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/printers"
+)
+
+// templateEncoder applies a Go template to the JSON representation of objects, the template is executed
+// for each object, or for each item if the object is a list, and each result is written in a line.
+type templateEncoder struct {
+	text     string
+	template *template.Template
+}
+
+var _ printers.ResourcePrinter = &templateEncoder{}
+
+// NewTemplateEncoder parses the Go template string and returns a printer to print objects with it
+func NewTemplateEncoder(text string) (printers.ResourcePrinter, error) {
+	if text == "" {
+		return nil, fmt.Errorf("template format is specified but no template is given, use --template or -o template=<template> to specify one")
+	}
+	t, err := template.New("output").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %q: %v", text, err)
+	}
+	return &templateEncoder{text: text, template: t}, nil
+}
+
+func (e *templateEncoder) PrintObj(obj runtime.Object, w io.Writer) error {
+	if meta.IsListType(obj) {
+		items, err := meta.ExtractList(obj)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err = e.PrintObj(item, w); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	var out interface{}
+	if err = json.Unmarshal(data, &out); err != nil {
+		return err
+	}
+	buf := &bytes.Buffer{}
+	if err = e.template.Execute(buf, out); err != nil {
+		return fmt.Errorf("failed to execute template %q: %v", e.text, err)
+	}
+	if buf.Len() > 0 && buf.Bytes()[buf.Len()-1] != '\n' {
+		buf.WriteByte('\n')
+	}
+	_, err = w.Write(buf.Bytes())
+	return err
+}
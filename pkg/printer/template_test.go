@@ -0,0 +1,67 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestTemplateEncoder(t *testing.T) {
+	if _, err := NewTemplateEncoder(""); err == nil {
+		t.Errorf("expect error for empty template")
+	}
+	if _, err := NewTemplateEncoder("{{.metadata.name"); err == nil {
+		t.Errorf("expect error for invalid template")
+	}
+
+	newObj := func(name string) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata":   map[string]interface{}{"name": name},
+		}}
+	}
+	p, err := NewTemplateEncoder("{{.kind}}/{{.metadata.name}}")
+	if err != nil {
+		t.Fatalf("failed to parse template: %v", err)
+	}
+	buf := &bytes.Buffer{}
+	obj := newObj("foo")
+	if err = p.PrintObj(&obj, buf); err != nil || buf.String() != "Pod/foo\n" {
+		t.Errorf("unexpected output %q, error: %v", buf.String(), err)
+	}
+
+	buf.Reset()
+	list := &unstructured.UnstructuredList{
+		Object: map[string]interface{}{"apiVersion": "v1", "kind": "List"},
+		Items:  []unstructured.Unstructured{newObj("foo"), newObj("bar")},
+	}
+	if err = p.PrintObj(list, buf); err != nil || buf.String() != "Pod/foo\nPod/bar\n" {
+		t.Errorf("unexpected output %q, error: %v", buf.String(), err)
+	}
+
+	p, _ = NewTemplateEncoder("{{.metadata.name.first}}")
+	if err = p.PrintObj(&obj, buf); err == nil {
+		t.Errorf("expect error for executing template")
+	}
+}
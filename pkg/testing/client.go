@@ -31,6 +31,7 @@ import (
 	appsv1beta1 "github.com/apecloud/kubeblocks/apis/apps/v1beta1"
 	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
 	extensionsv1alpha1 "github.com/apecloud/kubeblocks/apis/extensions/v1alpha1"
+	storagev1alpha1 "github.com/apecloud/kubeblocks/apis/storage/v1alpha1"
 	kbfakeclient "github.com/apecloud/kubeblocks/pkg/client/clientset/versioned/fake"
 )
 
@@ -43,6 +44,7 @@ func FakeDynamicClient(objects ...runtime.Object) *dynamicfakeclient.FakeDynamic
 	_ = appsv1beta1.AddToScheme(scheme.Scheme)
 	_ = extensionsv1alpha1.AddToScheme(scheme.Scheme)
 	_ = dpv1alpha1.AddToScheme(scheme.Scheme)
+	_ = storagev1alpha1.AddToScheme(scheme.Scheme)
 	return dynamicfakeclient.NewSimpleDynamicClient(scheme.Scheme, objects...)
 }
 
@@ -26,7 +26,9 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	fakediscovery "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/restmapper"
+	clienttesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
@@ -51,6 +53,14 @@ func NewTestFactory(namespace string) *cmdtesting.TestFactory {
 	return tf.WithClientConfig(clientConfig).WithNamespace(namespace)
 }
 
+// FakeDiscoveryClient returns a cached discovery client that serves the given API resources
+func FakeDiscoveryClient(resources ...*metav1.APIResourceList) *cmdtesting.FakeCachedDiscoveryClient {
+	discoveryClient := cmdtesting.NewFakeCachedDiscoveryClient()
+	discoveryClient.Resources = resources
+	discoveryClient.DiscoveryInterface = &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: resources}}
+	return discoveryClient
+}
+
 func testClientConfig() clientcmd.ClientConfig {
 	tmpFile, err := os.CreateTemp(os.TempDir(), "cmdtests_temp")
 	if err != nil {
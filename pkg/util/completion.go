@@ -127,3 +127,16 @@ func RegisterClusterCompletionFunc(cmd *cobra.Command, f cmdutil.Factory) {
 		},
 	))
 }
+
+func RegisterBackupCompletionFunc(cmd *cobra.Command, f cmdutil.Factory) {
+	if cmd.Flags().Lookup("backup") == nil {
+		return
+	}
+
+	cmdutil.CheckErr(cmd.RegisterFlagCompletionFunc(
+		"backup",
+		func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return utilcomp.CompGetResource(f, GVRToString(types.BackupGVR()), toComplete), cobra.ShellCompDirectiveNoFileComp
+		},
+	))
+}
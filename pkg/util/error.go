@@ -20,20 +20,35 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package util
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 )
 
 var (
 	invalidAuthAPIVersion     = "exec plugin: invalid apiVersion \"client.authentication.k8s.io/v1alpha1\""
 	invalidAuthAPIVersionHint = "if you are using Amazon EKS, please update AWS CLI to the latest version and update the kubeconfig file for your cluster,\nrefer to https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html"
+
+	errTimeout  = errors.New("timed out waiting for the command to complete")
+	timeoutHint = "use a larger --timeout to wait longer"
+
+	// transientErrorBackoff is the back-off of retrying the requests failed with transient errors
+	transientErrorBackoff = wait.Backoff{Duration: 200 * time.Millisecond, Factor: 2, Jitter: 0.5, Cap: 5 * time.Second}
 )
 
+// DefaultMaxRetries is the default number of times to retry the requests failed with transient errors.
+const DefaultMaxRetries = 5
+
 // CheckErr prints a user-friendly error to STDERR and exits with a non-zero exit code.
 func CheckErr(err error) {
 	// unwrap aggregates of 1
@@ -61,6 +76,13 @@ func CheckErr(err error) {
 		os.Exit(cmdutil.DefaultErrorExitCode)
 	}
 
+	// the context deadline is exceeded if the command runs longer than --timeout
+	if errors.Is(err, context.DeadlineExceeded) {
+		printErr(errTimeout)
+		fmt.Fprintf(os.Stderr, "hint: %s\n", timeoutHint)
+		os.Exit(cmdutil.DefaultErrorExitCode)
+	}
+
 	// check other errors
 	cmdutil.CheckErr(err)
 }
@@ -81,3 +103,31 @@ func printErr(err error) {
 		fmt.Fprint(os.Stderr, msg)
 	}
 }
+
+// IsTransientError returns true if the error is a transient error of the API server,
+// such as 429 Too Many Requests and 503 Service Unavailable, and the request can be retried.
+func IsTransientError(err error) bool {
+	return apierrors.IsTooManyRequests(err) || apierrors.IsServiceUnavailable(err) ||
+		apierrors.IsServerTimeout(err) || apierrors.IsTimeout(err)
+}
+
+// RetryOnTransientError runs fn and retries it at most maxRetries times with exponential back-off
+// and jitter if it fails with a transient error. fn always runs at least once, even if maxRetries is negative.
+func RetryOnTransientError(maxRetries int, fn func() error) error {
+	if maxRetries < 0 {
+		maxRetries = 0
+	}
+	backoff := transientErrorBackoff
+	backoff.Steps = maxRetries + 1
+	attempt := 0
+	return retry.OnError(backoff, func(err error) bool {
+		if !IsTransientError(err) {
+			return false
+		}
+		attempt++
+		if attempt <= maxRetries {
+			klog.V(1).Infof("request failed with transient error: %v, retrying (%d/%d)", err, attempt, maxRetries)
+		}
+		return true
+	}, fn)
+}
@@ -23,6 +23,9 @@ import (
 	"fmt"
 
 	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 var _ = Describe("util", func() {
@@ -31,4 +34,45 @@ var _ = Describe("util", func() {
 		err := fmt.Errorf("test error")
 		printErr(err)
 	})
+
+	It("Retry on transient errors", func() {
+		backoff := transientErrorBackoff
+		transientErrorBackoff.Duration = 0
+		defer func() { transientErrorBackoff = backoff }()
+
+		By("retry until success")
+		calls := 0
+		Expect(RetryOnTransientError(3, func() error {
+			calls++
+			if calls < 3 {
+				return apierrors.NewTooManyRequests("too many requests", 0)
+			}
+			return nil
+		})).Should(Succeed())
+		Expect(calls).Should(Equal(3))
+
+		By("give up after max retries")
+		calls = 0
+		Expect(apierrors.IsServiceUnavailable(RetryOnTransientError(2, func() error {
+			calls++
+			return apierrors.NewServiceUnavailable("unavailable")
+		}))).Should(BeTrue())
+		Expect(calls).Should(Equal(3))
+
+		By("do not retry other errors")
+		calls = 0
+		Expect(apierrors.IsNotFound(RetryOnTransientError(2, func() error {
+			calls++
+			return apierrors.NewNotFound(schema.GroupResource{Resource: "backups"}, "test")
+		}))).Should(BeTrue())
+		Expect(calls).Should(Equal(1))
+
+		By("run once with negative max retries")
+		calls = 0
+		Expect(apierrors.IsServiceUnavailable(RetryOnTransientError(-1, func() error {
+			calls++
+			return apierrors.NewServiceUnavailable("unavailable")
+		}))).Should(BeTrue())
+		Expect(calls).Should(Equal(1))
+	})
 })
@@ -383,4 +383,19 @@ var _ = Describe("flag", func() {
 			Expect(autoCompleteClusterComponent(cmd, tf, "component")).Should(Succeed())
 		})
 	})
+
+	It("test ParseInheritedFlags", func() {
+		var kubeContext, namespace, provider string
+		root := &cobra.Command{Use: "root"}
+		root.PersistentFlags().StringVar(&kubeContext, "context", "", "")
+		root.PersistentFlags().StringVarP(&namespace, "namespace", "n", "", "")
+		sub := &cobra.Command{Use: "sub", DisableFlagParsing: true}
+		sub.Flags().StringVar(&provider, "provider", "", "")
+		root.AddCommand(sub)
+
+		Expect(ParseInheritedFlags(sub, []string{"--provider", "s3", "--bucket", "test", "--context", "other", "-n", "ns", "--help"})).Should(Succeed())
+		Expect(kubeContext).Should(Equal("other"))
+		Expect(namespace).Should(Equal("ns"))
+		Expect(provider).Should(BeEmpty())
+	})
 })
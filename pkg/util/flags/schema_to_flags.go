@@ -56,6 +56,19 @@ func (t *TmpFlagSet) Check(args []string, customCheckFunc func() error) error {
 	return nil
 }
 
+// ParseInheritedFlags parses the flags inherited from the parent commands, such as --kubeconfig,
+// --context and --namespace, from the args of a command which disables the flag parsing, so that
+// these flags take effect before the factory initializes the clients.
+func ParseInheritedFlags(cmd *cobra.Command, args []string) error {
+	t := NewTmpFlagSet()
+	cmd.InheritedFlags().VisitAll(func(f *pflag.Flag) {
+		if t.Lookup(f.Name) == nil && (f.Shorthand == "" || t.ShorthandLookup(f.Shorthand) == nil) {
+			t.AddFlag(f)
+		}
+	})
+	return t.Parse(args)
+}
+
 func FlagsToValues(fs *pflag.FlagSet, explicit bool) map[string]pflag.Value {
 	values := make(map[string]pflag.Value)
 	fs.VisitAll(func(f *pflag.Flag) {
@@ -504,3 +504,59 @@ func getAPIInfo(api map[string]any) string {
 	res += "}"
 	return res
 }
+
+// OutputValuesDiff outputs the difference of the chart default values between two versions,
+// the nested values are flattened to the keys joined by dot.
+func OutputValuesDiff(valuesA, valuesB map[string]interface{}, versionA, versionB string, out io.Writer) {
+	flatA, flatB := map[string]string{}, map[string]string{}
+	flattenValues("", valuesA, flatA)
+	flattenValues("", valuesB, flatB)
+	keys := maps.Keys(flatA)
+	for k := range flatB {
+		if _, ok := flatA[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	tbl := printer.NewTablePrinter(out)
+	tbl.SetHeader("KEY", versionA, versionB)
+	for _, k := range keys {
+		a, okA := flatA[k]
+		b, okB := flatB[k]
+		if okA && okB && a == b {
+			continue
+		}
+		if !okA {
+			a = "<none>"
+		}
+		if !okB {
+			b = "<none>"
+		}
+		tbl.AddRow(k, a, b)
+	}
+	if tbl.Tbl.Length() == 0 {
+		fmt.Fprintf(out, "No changes of the default values between %s and %s\n", versionA, versionB)
+		return
+	}
+	tbl.Print()
+}
+
+func flattenValues(prefix string, values map[string]interface{}, res map[string]string) {
+	for k, v := range values {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if m, ok := v.(map[string]interface{}); ok && len(m) > 0 {
+			flattenValues(key, m, res)
+			continue
+		}
+		data, err := json.Marshal(v)
+		if err != nil {
+			res[key] = fmt.Sprintf("%v", v)
+			continue
+		}
+		res[key] = string(data)
+	}
+}
@@ -429,4 +429,29 @@ spec.name   true -> false   Modified
 		})
 
 	})
+
+	It("output values diff", func() {
+		valuesA := map[string]interface{}{
+			"image":        map[string]interface{}{"tag": "0.8.0", "registry": "docker.io"},
+			"replicaCount": 1,
+			"removed":      true,
+		}
+		valuesB := map[string]interface{}{
+			"image":        map[string]interface{}{"tag": "0.9.0", "registry": "docker.io"},
+			"replicaCount": 1,
+			"added":        "value",
+		}
+		out := &buffer.Buffer{}
+		OutputValuesDiff(valuesA, valuesB, "0.8.0", "0.9.0", out)
+		Expect(out.String()).Should(ContainSubstring("image.tag"))
+		Expect(out.String()).Should(ContainSubstring(`"0.9.0"`))
+		Expect(out.String()).Should(ContainSubstring("added"))
+		Expect(out.String()).Should(ContainSubstring("removed"))
+		Expect(out.String()).ShouldNot(ContainSubstring("replicaCount"))
+		Expect(out.String()).ShouldNot(ContainSubstring("image.registry"))
+
+		out.Reset()
+		OutputValuesDiff(valuesA, valuesA, "0.8.0", "0.8.0", out)
+		Expect(out.String()).Should(ContainSubstring("No changes"))
+	})
 })
@@ -76,6 +76,9 @@ type InstallOpts struct {
 	DisableHooks    bool
 	ForceUninstall  bool
 	Upgrader        breakingchange.Upgrader
+	// ValuesDiff outputs the difference of the chart default values between the installed
+	// and the upgraded version if it is not nil
+	ValuesDiff io.Writer
 
 	// for helm template
 	DryRun     *bool
@@ -523,6 +526,8 @@ func (i *InstallOpts) tryUpgrade(cfg *action.Configuration) (*release.Release, e
 	if err != nil {
 		return nil, err
 	}
+	// the default values of installed chart will be overwritten by the current values below
+	installedDefaultValues := installed.Chart.Values
 	// get coalesced values of current chart
 	currentValues, err := chartutil.CoalesceValues(installed.Chart, installed.Config)
 	if err != nil {
@@ -540,6 +545,12 @@ func (i *InstallOpts) tryUpgrade(cfg *action.Configuration) (*release.Release, e
 	if err != nil {
 		return nil, err
 	}
+	if i.ValuesDiff != nil {
+		OutputValuesDiff(installedDefaultValues, chartRequested.Values, installed.Chart.Metadata.Version,
+			chartRequested.Metadata.Version, i.ValuesDiff)
+		// output only once if the upgrade is retried
+		i.ValuesDiff = nil
+	}
 
 	// Create context and prepare the handle of SIGTERM
 	ctx := context.Background()
@@ -82,3 +82,17 @@ func Confirm(names []string, in io.Reader, customMessage string, prompt string)
 		}, in).Run()
 	return err
 }
+
+// Select lets user choose one of the items, returns the selected item
+func Select(label string, items []string, in io.Reader) (string, error) {
+	if len(items) == 0 {
+		return "", fmt.Errorf("no items to select")
+	}
+	p := promptui.Select{
+		Label: label,
+		Items: items,
+		Stdin: io.NopCloser(in),
+	}
+	_, item, err := p.Run()
+	return item, err
+}
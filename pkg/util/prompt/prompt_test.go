@@ -43,3 +43,19 @@ func Test(t *testing.T) {
 		t.Errorf("prompt result is not expected")
 	}
 }
+
+func TestSelect(t *testing.T) {
+	if _, err := Select("Please select one", nil, &bytes.Buffer{}); err == nil {
+		t.Errorf("expected an error when no items to select")
+	}
+
+	in := &bytes.Buffer{}
+	in.Write([]byte("\n"))
+	res, err := Select("Please select one", []string{"a", "b"}, in)
+	if err != nil {
+		t.Errorf("select error %v", err)
+	}
+	if res != "a" {
+		t.Errorf("select result is not expected")
+	}
+}
@@ -48,6 +48,7 @@ import (
 	"github.com/pmezard/go-difflib/difflib"
 	"github.com/spf13/cobra"
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/term"
 	corev1 "k8s.io/api/core/v1"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -1123,3 +1124,9 @@ func GetClusterNameFromArgsOrFlag(cmd *cobra.Command, args []string) string {
 	}
 	return ""
 }
+
+// IsTerminal returns true if the given stream is a terminal
+func IsTerminal(stream interface{}) bool {
+	f, ok := stream.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}